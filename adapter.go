@@ -0,0 +1,154 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseOption tells which fields the cron spec has, like that of robfig/cron.
+type ParseOption int
+
+// The fields of cron spec in the order they appear.
+const (
+	Second         ParseOption = 1 << iota // Seconds field, default 0
+	SecondOptional                         // Optional seconds field, default 0
+	Minute                                 // Minutes field, default 0
+	Hour                                   // Hours field, default 0
+	Dom                                    // Day of month field, default *
+	Month                                  // Month field, default *
+	Dow                                    // Day of week field, default *
+	DowOptional                            // Optional day of week field, default *
+	Descriptor                             // Allow descriptors such as @monthly, @weekly, @every 1h
+)
+
+var layout = []struct {
+	opt, optional ParseOption
+	def           string
+}{
+	{Second, SecondOptional, "0"}, {Minute, 0, "0"}, {Hour, 0, "0"},
+	{Dom, 0, "*"}, {Month, 0, "*"}, {Dow, DowOptional, "*"},
+}
+
+// Parser parses cron spec of given field layout into Schedule.
+type Parser struct {
+	opts ParseOption
+}
+
+// NewParser gives a Parser for cron specs with given fields.
+// eg: NewParser(Second | Minute | Hour | Dom | Month | Dow | Descriptor)
+func NewParser(opts ParseOption) Parser {
+	return Parser{opts}
+}
+
+// StandardParser parses standard 5 fields spec (and descriptors) like robfig/cron.
+var StandardParser = NewParser(Minute | Hour | Dom | Month | Dow | Descriptor)
+
+// ParseStandard parses standard 5 fields cron spec (or descriptor) into Schedule.
+// It returns Schedule or error if spec is invalid.
+func ParseStandard(spec string) (*Schedule, error) {
+	return StandardParser.Parse(spec)
+}
+
+// Parse parses cron spec into Schedule. The spec can be prefixed by time zone as
+// `TZ=Asia/Tokyo` or `CRON_TZ=Asia/Tokyo`, and can be followed by gronx extras
+// viz <year> and ISO week or day of year segments.
+// It returns Schedule or error if spec is invalid.
+func (p Parser) Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	var loc *time.Location
+	if strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=") {
+		parts := strings.SplitN(spec, " ", 2)
+		name := parts[0][strings.Index(parts[0], "=")+1:]
+		zone, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone: %s", name)
+		}
+
+		loc, spec = zone, ""
+		if len(parts) > 1 {
+			spec = strings.TrimSpace(parts[1])
+		}
+	}
+
+	expr, err := p.expr(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	sched.loc = loc
+	return sched, nil
+}
+
+// expr converts spec of parser's layout into gronx expr.
+func (p Parser) expr(spec string) (string, error) {
+	if strings.HasPrefix(spec, "@") {
+		if p.opts&Descriptor == 0 {
+			return "", errors.New("descriptors not allowed: " + spec)
+		}
+		return spec, nil
+	}
+
+	// Pop gronx extras: <year>, ISO week and day of year
+	fields, extras := strings.Fields(spec), []string{}
+	for len(fields) > 0 && len(extras) < 3 {
+		last := fields[len(fields)-1]
+		if !yearRe.MatchString(last) && !extRe.MatchString(strings.ToUpper(last)) {
+			break
+		}
+		fields, extras = fields[0:len(fields)-1], append([]string{last}, extras...)
+	}
+
+	min, max := 0, 0
+	for _, f := range layout {
+		if p.opts&(f.opt|f.optional) != 0 {
+			max++
+			if p.opts&f.optional == 0 {
+				min++
+			}
+		}
+	}
+	if len(fields) < min || len(fields) > max {
+		return "", fmt.Errorf("expected %d to %d fields, got %d: %s", min, max, len(fields), spec)
+	}
+
+	// The optional fields are omitted first
+	skip := max - len(fields)
+	segs := make([]string, 0, len(layout)+len(extras))
+	for _, f := range layout {
+		if p.opts&(f.opt|f.optional) == 0 || (skip > 0 && p.opts&f.optional != 0) {
+			if p.opts&f.optional != 0 {
+				skip--
+			}
+			segs = append(segs, f.def)
+			continue
+		}
+		segs, fields = append(segs, fields[0]), fields[1:]
+	}
+
+	if len(extras) > 0 && !yearRe.MatchString(extras[0]) {
+		segs = append(segs, "*")
+	}
+	return strings.Join(append(segs, extras...), " "), nil
+}
+
+// Next gives next run time after t, or zero time if there is none.
+// It makes Schedule usable in the schedulers that accept `Next(time.Time) time.Time`,
+// eg: robfig/cron and go-co-op/gocron.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.loc != nil {
+		t = t.In(s.loc)
+	}
+
+	next, err := s.NextTickAfter(t, false)
+	if err != nil {
+		return time.Time{}
+	}
+	return next
+}
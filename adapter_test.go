@@ -0,0 +1,90 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParser(t *testing.T) {
+	full := NewParser(Second | Minute | Hour | Dom | Month | Dow | Descriptor)
+	optional := NewParser(SecondOptional | Minute | Hour | Dom | Month | DowOptional)
+
+	tests := []struct {
+		parser     Parser
+		spec, expr string
+	}{
+		{StandardParser, "5 9 * * MON", "0 5 9 * * MON"},
+		{StandardParser, "0 0 L * * 2030", "0 0 0 L * * 2030"},
+		{StandardParser, "0 0 * * * W1", "0 0 0 * * * * W1"},
+		{StandardParser, "@daily", "@daily"},
+		{StandardParser, "@every 90m", "@every 90m"},
+		{full, "30 5 9 * * 5#2", "30 5 9 * * 5#2"},
+		{optional, "5 9 * *", "0 5 9 * * *"},
+		{optional, "5 9 * * FRI", "0 5 9 * * FRI"},
+		{optional, "30 5 9 * * FRI", "30 5 9 * * FRI"},
+		{NewParser(Hour | Minute), "30 9", "0 30 9 * * *"},
+	}
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			expr, err := test.parser.expr(test.spec)
+			if err != nil || expr != test.expr {
+				t.Errorf("expected %s, got %s (err: %v)", test.expr, expr, err)
+			}
+			if _, err := test.parser.Parse(test.spec); err != nil {
+				t.Errorf("expected nil, got %v", err)
+			}
+		})
+	}
+
+	for _, spec := range []string{"* * * *", "* * * * * * *", "0 60 * * *", "TZ=Nowhere/Foo * * * * *", "TZ=UTC"} {
+		t.Run("err "+spec, func(t *testing.T) {
+			if _, err := ParseStandard(spec); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+
+	t.Run("no descriptor", func(t *testing.T) {
+		if _, err := optional.Parse("@hourly"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestScheduleNext(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+
+	t.Run("next", func(t *testing.T) {
+		sched, _ := ParseStandard("0 9 L * *")
+		expect, _ := time.Parse(FullDateFormat, "2021-04-30 09:00:00")
+		if next := sched.Next(ref); !next.Equal(expect) {
+			t.Errorf("expected %v, got %v", expect, next)
+		}
+		// excludes given time
+		if next := sched.Next(expect); !next.After(expect) {
+			t.Errorf("expected after %v, got %v", expect, next)
+		}
+	})
+
+	t.Run("zone", func(t *testing.T) {
+		sched, err := ParseStandard("TZ=Asia/Kathmandu 0 9 * * *")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		next := sched.Next(ref)
+		if expect := "2021-04-20 09:00:00 +0545"; next.Format(FullDateFormat+" -0700") != expect {
+			t.Errorf("expected %s, got %s", expect, next.Format(FullDateFormat+" -0700"))
+		}
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		sched, _ := ParseStandard("0 0 1 1 * 2020")
+		if next := sched.Next(ref); !next.IsZero() {
+			t.Errorf("expected zero time, got %v", next)
+		}
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		var _ interface{ Next(time.Time) time.Time } = &Schedule{}
+	})
+}
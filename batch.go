@@ -1,7 +1,10 @@
 package gronx
 
 import (
+	"context"
+	"runtime"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -15,7 +18,7 @@ type Expr struct {
 // BatchDue checks if multiple expressions are due for given time (or now).
 // It returns []Expr with filled in Due and Err values.
 func (g *Gronx) BatchDue(exprs []string, ref ...time.Time) []Expr {
-	ref = append(ref, time.Now())
+	ref = append(ref, g.now())
 	g.C.SetRef(ref[0])
 
 	var segs []string
@@ -23,7 +26,7 @@ func (g *Gronx) BatchDue(exprs []string, ref ...time.Time) []Expr {
 	cache, batch := map[string]Expr{}, make([]Expr, len(exprs))
 	for i := range exprs {
 		batch[i].Expr = exprs[i]
-		segs, batch[i].Err = Segments(exprs[i])
+		segs, batch[i].Err = g.segments(exprs[i])
 		key := strings.Join(segs, " ")
 		if batch[i].Err != nil {
 			cache[key] = batch[i]
@@ -36,6 +39,12 @@ func (g *Gronx) BatchDue(exprs []string, ref ...time.Time) []Expr {
 			continue
 		}
 
+		if segs[0] == everyTag {
+			batch[i].Due, batch[i].Err = g.SegmentsDue(segs)
+			cache[key] = batch[i]
+			continue
+		}
+
 		due := true
 		for pos, seg := range segs {
 			if seg != "*" && seg != "?" {
@@ -44,8 +53,131 @@ func (g *Gronx) BatchDue(exprs []string, ref ...time.Time) []Expr {
 				}
 			}
 		}
-		batch[i].Due = due
+		batch[i].Due = due && !g.isExcluded(ref[0])
+		cache[key] = batch[i]
+	}
+	return batch
+}
+
+// batchChunk is the number of exprs a worker of BatchDueParallel checks at once.
+const batchChunk = 1024
+
+// BatchDueParallel checks if multiple expressions are due for given time (or now) like
+// BatchDue, sharding them across workers (NumCPU if less than 1) each with its own checker.
+// Custom Checker (other than SegmentChecker) is not cloned so it is checked by one worker.
+// It returns []Expr in the order of exprs, and ctx.Err() if ctx is done before all are
+// checked, in which case the unchecked items have it as Err.
+func (g *Gronx) BatchDueParallel(ctx context.Context, exprs []string, workers int, ref ...time.Time) ([]Expr, error) {
+	ref = append(ref, g.now())
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	if _, ok := g.C.(*SegmentChecker); !ok {
+		workers = 1
+	}
+
+	var wg sync.WaitGroup
+	batch, chunks := make([]Expr, len(exprs)), make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(gron *Gronx) {
+			defer wg.Done()
+			for start := range chunks {
+				end := start + batchChunk
+				if end > len(exprs) {
+					end = len(exprs)
+				}
+				copy(batch[start:end], gron.BatchDue(exprs[start:end], ref[0]))
+			}
+		}(g.clone())
+	}
+
+	var err error
+	for start := 0; start < len(exprs) && err == nil; start += batchChunk {
+		if err = ctx.Err(); err == nil {
+			select {
+			case chunks <- start:
+				continue
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+		for i := start; i < len(exprs); i++ {
+			batch[i] = Expr{Err: err, Expr: exprs[i]}
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+	return batch, err
+}
+
+// Tick represents an item in array for batch next/prev tick
+type Tick struct {
+	Err  error
+	Expr string
+	Time time.Time
+}
+
+// BatchNext gives next run time after given time (or now) for multiple expressions.
+// It returns []Tick with filled in Time and Err values.
+func (g *Gronx) BatchNext(exprs []string, ref ...time.Time) []Tick {
+	return g.batchTick(exprs, false, ref...)
+}
+
+// BatchPrev gives previous run time before given time (or now) for multiple expressions.
+// It returns []Tick with filled in Time and Err values.
+func (g *Gronx) BatchPrev(exprs []string, ref ...time.Time) []Tick {
+	return g.batchTick(exprs, true, ref...)
+}
+
+func (g *Gronx) batchTick(exprs []string, reverse bool, ref ...time.Time) []Tick {
+	ref = append(ref, g.now())
+
+	var segs []string
+
+	cache, batch := map[string]Tick{}, make([]Tick, len(exprs))
+	for i := range exprs {
+		batch[i].Expr = exprs[i]
+		if segs, batch[i].Err = g.segments(exprs[i]); batch[i].Err != nil {
+			continue
+		}
+
+		key := strings.Join(segs, " ")
+		if c, ok := cache[key]; ok {
+			batch[i] = c
+			batch[i].Expr = exprs[i]
+			continue
+		}
+
+		if reverse {
+			batch[i].Time, batch[i].Err = g.tickBefore(segs, ref[0], false)
+		} else {
+			batch[i].Time, batch[i].Err = g.tickAfter(segs, ref[0], false)
+		}
 		cache[key] = batch[i]
 	}
 	return batch
 }
+
+// Earliest gives the soonest Tick among those without error.
+// It returns Tick and false if there is none.
+func Earliest(ticks []Tick) (tick Tick, ok bool) {
+	for _, t := range ticks {
+		if t.Err == nil && (!ok || t.Time.Before(tick.Time)) {
+			tick, ok = t, true
+		}
+	}
+	return
+}
+
+// Latest gives the most recent Tick among those without error.
+// It returns Tick and false if there is none.
+func Latest(ticks []Tick) (tick Tick, ok bool) {
+	for _, t := range ticks {
+		if t.Err == nil && (!ok || t.Time.After(tick.Time)) {
+			tick, ok = t, true
+		}
+	}
+	return
+}
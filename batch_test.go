@@ -1,6 +1,7 @@
 package gronx
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -38,3 +39,118 @@ func TestBatch(t *testing.T) {
 		}
 	})
 }
+
+func TestBatchTick(t *testing.T) {
+	gron := New()
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+	exprs := []string{"0 * * * *", "*/5 * * * *", "*/5  *  *  *  *", "* * * *", "0 0 1 1 * 2018"}
+
+	t.Run("batch next", func(t *testing.T) {
+		ticks := gron.BatchNext(exprs, ref)
+		expect := []string{"2021-04-19 13:00:00", "2021-04-19 12:55:00", "2021-04-19 12:55:00"}
+		for i, tick := range ticks {
+			if tick.Expr != exprs[i] {
+				t.Errorf("expected expr %s, got %s", exprs[i], tick.Expr)
+			}
+			if i >= len(expect) {
+				if tick.Err == nil {
+					t.Errorf("%s expected error", tick.Expr)
+				}
+				continue
+			}
+			if actual := tick.Time.Format(FullDateFormat); tick.Err != nil || actual != expect[i] {
+				t.Errorf("%s expected %s, got %s (%v)", tick.Expr, expect[i], actual, tick.Err)
+			}
+		}
+
+		earliest, ok := Earliest(ticks)
+		if !ok || earliest.Expr != exprs[1] {
+			t.Errorf("expected earliest %s, got %s", exprs[1], earliest.Expr)
+		}
+	})
+
+	t.Run("batch prev", func(t *testing.T) {
+		ticks := gron.BatchPrev(exprs, ref)
+		expect := []string{"2021-04-19 12:00:00", "2021-04-19 12:50:00", "2021-04-19 12:50:00"}
+		for i, tick := range ticks[0:len(expect)] {
+			if actual := tick.Time.Format(FullDateFormat); tick.Err != nil || actual != expect[i] {
+				t.Errorf("%s expected %s, got %s (%v)", tick.Expr, expect[i], actual, tick.Err)
+			}
+		}
+
+		latest, ok := Latest(ticks)
+		if !ok || latest.Expr != exprs[1] {
+			t.Errorf("expected latest %s, got %s", exprs[1], latest.Expr)
+		}
+		if _, ok := Earliest(ticks[3:4]); ok {
+			t.Error("expected no earliest of erroneous ticks")
+		}
+	})
+}
+
+func TestBatchDueParallel(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+	var exprs []string
+	for i := 0; len(exprs) < 5*batchChunk+7; i++ {
+		exprs = append(exprs, fmt.Sprintf("%d %d * * *", i%60, 12+i%3), "*/7 * * * *", "* * * *")
+	}
+
+	t.Run("parity", func(t *testing.T) {
+		expect := New().BatchDue(exprs, ref)
+		for _, workers := range []int{0, 1, 3} {
+			actual, err := New().BatchDueParallel(context.Background(), exprs, workers, ref)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+			for i := range expect {
+				if actual[i].Expr != expect[i].Expr || actual[i].Due != expect[i].Due || (actual[i].Err == nil) != (expect[i].Err == nil) {
+					t.Fatalf("workers=%d #%d: expected %+v, got %+v", workers, i, expect[i], actual[i])
+				}
+			}
+		}
+	})
+
+	t.Run("custom checker", func(t *testing.T) {
+		gron := &Gronx{C: struct{ *SegmentChecker }{&SegmentChecker{}}}
+		expect := New().BatchDue(exprs[0:6], ref)
+		batch, err := gron.BatchDueParallel(context.Background(), exprs[0:6], 4, ref)
+		for i := range expect {
+			if err != nil || batch[i].Due != expect[i].Due || (batch[i].Err == nil) != (expect[i].Err == nil) {
+				t.Errorf("#%d: expected %+v, got %+v (err: %v)", i, expect[i], batch[i], err)
+			}
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		batch, err := New().BatchDueParallel(ctx, exprs, 2, ref)
+		if err != context.Canceled || len(batch) != len(exprs) {
+			t.Fatalf("expected context.Canceled and full batch, got %v and %d", err, len(batch))
+		}
+		for i, expr := range batch {
+			if expr.Expr != exprs[i] || expr.Err != context.Canceled {
+				t.Fatalf("#%d: expected canceled %s, got %+v", i, exprs[i], expr)
+			}
+		}
+	})
+}
+
+func BenchmarkBatchDue(b *testing.B) {
+	exprs := make([]string, 100000)
+	for i := range exprs {
+		exprs[i] = fmt.Sprintf("%d %d * * %d", i%60, i%24, i%7)
+	}
+	ref := time.Now()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			New().BatchDue(exprs, ref)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			New().BatchDueParallel(context.Background(), exprs, 0, ref)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package gronx
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultCacheSize is the size of Cache if not given.
+const DefaultCacheSize = 1024
+
+// Cache is a bounded LRU cache of parsed segments (and validity) of cron exprs
+// keyed by the raw expr, so that repeated checks of same expr skip parsing.
+// The parsed exprs don't depend on Calendar or CalendarSystem, and the cache drops
+// all of them as soon as tags, modifiers or locales change (AddTag and (Un)Register*).
+// It is safe for concurrent use and can be shared by many Gronx.
+type Cache struct {
+	size    int
+	order   *list.List
+	items   map[string]*list.Element
+	stats   CacheStats
+	version uint64
+	mu      sync.Mutex
+}
+
+// registry is the version of tags, modifiers and locales that parsing depends on.
+var registry uint64
+
+// bumpRegistry marks the change of tags, modifiers or locales so that caches drop stale exprs.
+func bumpRegistry() {
+	atomic.AddUint64(&registry, 1)
+}
+
+// CacheStats is the usage statistics of Cache.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Len is the number of exprs in cache, Size is the max of it.
+	Len  int
+	Size int
+}
+
+type cacheEntry struct {
+	err   error
+	expr  string
+	segs  []string
+	valid bool
+}
+
+// NewCache gives Cache of given size (or DefaultCacheSize if less than 1).
+func NewCache(size int) *Cache {
+	if size < 1 {
+		size = DefaultCacheSize
+	}
+	return &Cache{size: size, order: list.New(), items: map[string]*list.Element{}, version: atomic.LoadUint64(&registry)}
+}
+
+// WithCache sets the Cache that IsValid, IsDue, NextTickAfter, PrevTickBefore,
+// batch checks and Compile look up the parsed exprs in.
+// It returns itself for fluency.
+func (g *Gronx) WithCache(cache *Cache) *Gronx {
+	g.Cache = cache
+	return g
+}
+
+// segments gives cron parts of expr from Cache if any.
+func (g *Gronx) segments(expr string) ([]string, error) {
+	if g.Cache == nil {
+		return Segments(expr)
+	}
+	return g.Cache.Segments(expr)
+}
+
+// Segments gives cron parts of expr as per gronx.Segments, parsing it only
+// if it is not in cache. The parts must not be modified.
+// It returns array or error.
+func (c *Cache) Segments(expr string) ([]string, error) {
+	entry := c.get(expr)
+	return entry.segs, entry.err
+}
+
+// IsValid checks if cron expression is valid, checking it only if it is not in cache.
+// It returns bool.
+func (c *Cache) IsValid(expr string) bool {
+	return c.get(expr).valid
+}
+
+// Stats gives the usage statistics of cache.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Len, stats.Size = c.order.Len(), c.size
+	return stats
+}
+
+// Purge removes all exprs from cache and resets the stats.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.items, c.stats = map[string]*list.Element{}, CacheStats{}
+}
+
+func (c *Cache) get(expr string) *cacheEntry {
+	c.mu.Lock()
+	version := atomic.LoadUint64(&registry)
+	if version != c.version {
+		c.order.Init()
+		c.items, c.version = map[string]*list.Element{}, version
+	}
+	if elem, ok := c.items[expr]; ok {
+		c.order.MoveToFront(elem)
+		c.stats.Hits++
+		c.mu.Unlock()
+		return elem.Value.(*cacheEntry)
+	}
+	c.stats.Misses++
+	c.mu.Unlock()
+
+	// Parse out of lock, a concurrent miss of same expr just parses it twice
+	entry := &cacheEntry{expr: expr}
+	entry.segs, entry.err = Segments(expr)
+	entry.valid = entry.err == nil && validSegments(entry.segs)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if version != c.version {
+		// Registry changed while parsing, so the entry may already be stale
+		return entry
+	}
+	if elem, ok := c.items[expr]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*cacheEntry)
+	}
+
+	c.items[expr] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).expr)
+		c.stats.Evictions++
+	}
+	return entry
+}
@@ -0,0 +1,139 @@
+package gronx
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	t.Run("hits and misses", func(t *testing.T) {
+		cache := NewCache(10)
+		for i := 0; i < 3; i++ {
+			segs, err := cache.Segments("*/5 * * * *")
+			if err != nil || len(segs) != 6 || segs[1] != "*/5" {
+				t.Errorf("expected segments, got %v (err: %v)", segs, err)
+			}
+		}
+		if cache.IsValid("0 60 * * *") || cache.IsValid("0 60 * * *") {
+			t.Errorf("expected invalid")
+		}
+		if _, err := cache.Segments("* * *"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+
+		stats := cache.Stats()
+		if stats.Hits != 3 || stats.Misses != 3 || stats.Len != 3 || stats.Size != 10 {
+			t.Errorf("expected 3 hits, 3 misses and 3 len, got %+v", stats)
+		}
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		cache := NewCache(2)
+		cache.IsValid("@daily")
+		cache.IsValid("@hourly")
+		cache.IsValid("@daily")
+		cache.IsValid("@weekly")
+
+		if stats := cache.Stats(); stats.Evictions != 1 || stats.Len != 2 {
+			t.Errorf("expected 1 eviction and 2 len, got %+v", stats)
+		}
+		cache.IsValid("@daily")
+		if stats := cache.Stats(); stats.Hits != 2 {
+			t.Errorf("@daily should still be cached, got %+v", stats)
+		}
+		cache.IsValid("@hourly")
+		if stats := cache.Stats(); stats.Misses != 4 {
+			t.Errorf("@hourly should have been evicted, got %+v", stats)
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		cache := NewCache(0)
+		cache.IsValid("@daily")
+		cache.Purge()
+		if stats := cache.Stats(); stats.Len != 0 || stats.Misses != 0 || stats.Size != DefaultCacheSize {
+			t.Errorf("expected empty cache, got %+v", stats)
+		}
+	})
+
+	t.Run("registry change", func(t *testing.T) {
+		if err := RegisterLocale("xx", Locale{
+			Months:   [12]string{"Jan1", "Feb2", "Mar3", "Apr4", "May5", "Jun6", "Jul7", "Aug8", "Sep9", "Oct10", "Nov11", "Dec12"},
+			Weekdays: [7]string{"Sun0", "Mon1", "Tue2", "Wed3", "Thu4", "Fri5", "Sat6"},
+		}); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		t.Cleanup(func() { UnregisterLocale("xx") })
+
+		cache := NewCache(10)
+		if !cache.IsValid("0 9 * Jun6 *") {
+			t.Fatal("expected valid with registered locale")
+		}
+		UnregisterLocale("xx")
+		if cache.IsValid("0 9 * Jun6 *") {
+			t.Error("expected invalid after locale is unregistered")
+		}
+		if stats := cache.Stats(); stats.Misses != 2 || stats.Len != 1 {
+			t.Errorf("expected stale expr to be dropped, got %+v", stats)
+		}
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		cache := NewCache(5)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					expr := fmt.Sprintf("%d * * * *", (i+j)%10)
+					if !cache.IsValid(expr) {
+						t.Errorf("%s should be valid", expr)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+		if stats := cache.Stats(); stats.Hits+stats.Misses != 800 || stats.Len != 5 {
+			t.Errorf("expected 800 lookups and 5 len, got %+v", stats)
+		}
+	})
+}
+
+func TestGronxWithCache(t *testing.T) {
+	cache := NewCache(100)
+	gron := New().WithCache(cache)
+
+	t.Run("parity", func(t *testing.T) {
+		for _, test := range testcases() {
+			ref, _ := time.Parse(FullDateFormat, test.Ref)
+			expect, eerr := New().IsDue(test.Expr, ref)
+			for i := 0; i < 2; i++ {
+				actual, err := gron.IsDue(test.Expr, ref)
+				if actual != expect || (err == nil) != (eerr == nil) {
+					t.Errorf("%s: expected %v (err: %v), got %v (err: %v)", test.Expr, expect, eerr, actual, err)
+				}
+			}
+			if gron.IsValid(test.Expr) != IsValid(test.Expr) {
+				t.Errorf("%s: expected same validity", test.Expr)
+			}
+		}
+	})
+
+	t.Run("across calls", func(t *testing.T) {
+		cache.Purge()
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+		exprs := []string{"0 9 * * MON-FRI", "@every 90m"}
+		gron.BatchDue(exprs, ref)
+		gron.BatchDue(exprs, ref)
+		gron.NextTickAfter(exprs[0], ref, false)
+		gron.PrevTickBefore(exprs[1], ref, false)
+		gron.Compile(exprs[0])
+
+		if stats := cache.Stats(); stats.Misses != 2 || stats.Hits != 5 {
+			t.Errorf("expected 2 misses and 5 hits, got %+v", stats)
+		}
+	})
+}
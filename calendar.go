@@ -0,0 +1,131 @@
+package gronx
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// DateFormat is Y-m-d (date only)
+const DateFormat = "2006-01-02"
+
+// Calendar decides which days are excluded from being due, eg: bank holidays.
+type Calendar interface {
+	IsExcluded(ref time.Time) bool
+}
+
+// DateCalendar excludes a fixed list of dates.
+type DateCalendar map[string]bool
+
+// NewDateCalendar creates DateCalendar excluding given dates.
+func NewDateCalendar(dates ...time.Time) DateCalendar {
+	cal := DateCalendar{}
+	for _, date := range dates {
+		cal[date.Format(DateFormat)] = true
+	}
+	return cal
+}
+
+// IsExcluded checks if the date of ref is in the list.
+func (c DateCalendar) IsExcluded(ref time.Time) bool {
+	return c[ref.Format(DateFormat)]
+}
+
+// AnnualCalendar excludes same month and day every year, eg: 12-25.
+type AnnualCalendar map[string]bool
+
+// NewAnnualCalendar creates AnnualCalendar excluding given month and day pairs.
+func NewAnnualCalendar(days ...time.Time) AnnualCalendar {
+	cal := AnnualCalendar{}
+	for _, day := range days {
+		cal[day.Format("01-02")] = true
+	}
+	return cal
+}
+
+// IsExcluded checks if the month and day of ref is in the list.
+func (c AnnualCalendar) IsExcluded(ref time.Time) bool {
+	return c[ref.Format("01-02")]
+}
+
+// WeeklyCalendar excludes same weekdays every week, eg: weekends.
+type WeeklyCalendar [7]bool
+
+// NewWeeklyCalendar creates WeeklyCalendar excluding given weekdays.
+func NewWeeklyCalendar(days ...time.Weekday) WeeklyCalendar {
+	var cal WeeklyCalendar
+	for _, day := range days {
+		cal[day%7] = true
+	}
+	return cal
+}
+
+// IsExcluded checks if the weekday of ref is blacked out.
+func (c WeeklyCalendar) IsExcluded(ref time.Time) bool {
+	return c[ref.Weekday()]
+}
+
+// Calendars combines many calendars, a day is excluded if any of them excludes it.
+type Calendars []Calendar
+
+// IsExcluded checks if any of the calendars exclude ref.
+func (c Calendars) IsExcluded(ref time.Time) bool {
+	for _, cal := range c {
+		if cal != nil && cal.IsExcluded(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadHolidays reads holidays file into a Calendar. See ParseHolidays for format.
+// It returns Calendar or error if any.
+func LoadHolidays(file string) (Calendar, error) {
+	fh, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+
+	return ParseHolidays(fh)
+}
+
+var weekdays = map[string]time.Weekday{
+	"SUN": time.Sunday, "MON": time.Monday, "TUE": time.Tuesday, "WED": time.Wednesday,
+	"THU": time.Thursday, "FRI": time.Friday, "SAT": time.Saturday,
+}
+
+// ParseHolidays parses holidays, one per line with optional description after it:
+// `2022-12-26` for fixed date, `12-25` for every year and `SAT` for every week.
+// Empty lines and lines starting with # are ignored.
+// It returns Calendar or error if any.
+func ParseHolidays(r io.Reader) (Calendar, error) {
+	dates, annual, weekly := DateCalendar{}, AnnualCalendar{}, WeeklyCalendar{}
+
+	scan := bufio.NewScanner(r)
+	for scan.Scan() {
+		ln := strings.TrimSpace(scan.Text())
+		if ln == "" || ln[0] == '#' {
+			continue
+		}
+
+		day := strings.Fields(ln)[0]
+		if wd, ok := weekdays[strings.ToUpper(day)]; ok {
+			weekly[wd] = true
+		} else if date, err := time.Parse(DateFormat, day); err == nil {
+			dates[date.Format(DateFormat)] = true
+		} else if date, err := time.Parse("01-02", day); err == nil {
+			annual[date.Format("01-02")] = true
+		} else {
+			return nil, errors.New("invalid holiday: " + ln)
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+
+	return Calendars{dates, annual, weekly}, nil
+}
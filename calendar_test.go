@@ -0,0 +1,116 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendar(t *testing.T) {
+	parse := func(ref string) time.Time {
+		tm, _ := time.Parse(FullDateFormat, ref)
+		return tm
+	}
+
+	t.Run("calendars", func(t *testing.T) {
+		cal := Calendars{
+			NewDateCalendar(parse("2022-12-26 00:00:00")),
+			NewAnnualCalendar(parse("2000-12-25 00:00:00")),
+			NewWeeklyCalendar(time.Saturday),
+		}
+		tests := map[string]bool{
+			"2022-12-26 10:00:00": true,
+			"2023-12-26 10:00:00": false,
+			"2023-12-25 10:00:00": true,
+			"2022-12-24 10:00:00": true,
+			"2022-12-27 10:00:00": false,
+		}
+		for ref, expect := range tests {
+			if actual := cal.IsExcluded(parse(ref)); actual != expect {
+				t.Errorf("excluded %s: expected %v, got %v", ref, expect, actual)
+			}
+		}
+	})
+
+	t.Run("load holidays", func(t *testing.T) {
+		cal, err := LoadHolidays("test/holidays.txt")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		for _, ref := range []string{"2022-12-26 00:00:00", "2030-01-01 00:00:00", "2022-11-05 00:00:00", "2022-11-06 00:00:00"} {
+			if !cal.IsExcluded(parse(ref)) {
+				t.Errorf("%s should be excluded", ref)
+			}
+		}
+		if cal.IsExcluded(parse("2022-11-07 00:00:00")) {
+			t.Error("2022-11-07 should not be excluded")
+		}
+
+		if _, err := LoadHolidays("test/holidays.txtx"); err == nil {
+			t.Error("expected error for missing file")
+		}
+		if _, err := ParseHolidays(strings.NewReader("2022-13-45")); err == nil {
+			t.Error("expected error for invalid holiday")
+		}
+	})
+
+	gron := New().WithCalendar(Calendars{
+		NewAnnualCalendar(parse("2000-12-25 00:00:00"), parse("2000-12-26 00:00:00")),
+		NewWeeklyCalendar(time.Saturday, time.Sunday),
+	})
+
+	t.Run("is due", func(t *testing.T) {
+		if due, _ := gron.IsDue("0 9 * * *", parse("2022-12-26 09:00:00")); due {
+			t.Error("0 9 * * * should not be due on holiday")
+		}
+		if due, _ := gron.IsDue("0 9 * * *", parse("2022-12-27 09:00:00")); !due {
+			t.Error("0 9 * * * should be due on working day")
+		}
+		for _, expr := range gron.BatchDue([]string{"0 9 * * *", "@every 1h"}, parse("2022-12-24 09:00:00")) {
+			if expr.Due {
+				t.Errorf("%s should not be due on weekend", expr.Expr)
+			}
+		}
+	})
+
+	t.Run("next and prev tick", func(t *testing.T) {
+		tests := []struct{ expr, ref, next, prev string }{
+			{"0 9 * * *", "2022-12-23 10:00:00", "2022-12-27 09:00:00", "2022-12-23 09:00:00"},
+			{"0 9 * * *", "2022-12-27 08:00:00", "2022-12-27 09:00:00", "2022-12-23 09:00:00"},
+			{"0 9 1 * *", "2022-12-20 00:00:00", "2023-02-01 09:00:00", "2022-12-01 09:00:00"},
+			{"@every 12h", "2022-12-23 13:00:00", "2022-12-27 00:00:00", "2022-12-23 12:00:00"},
+		}
+		for _, test := range tests {
+			next, err := gron.NextTickAfter(test.expr, parse(test.ref), false)
+			if actual := next.Format(FullDateFormat); err != nil || actual != test.next {
+				t.Errorf("next of %s after %s: expected %s, got %s (%v)", test.expr, test.ref, test.next, actual, err)
+			}
+			prev, err := gron.PrevTickBefore(test.expr, parse(test.ref), false)
+			if actual := prev.Format(FullDateFormat); err != nil || actual != test.prev {
+				t.Errorf("prev of %s before %s: expected %s, got %s (%v)", test.expr, test.ref, test.prev, actual, err)
+			}
+		}
+	})
+
+	t.Run("business days", func(t *testing.T) {
+		// 2022-12-26 and 2022-12-27 are mon and tue, 2022-12-25 is sunday
+		tests := []struct{ expr, ref, next, prev string }{
+			{"0 9 26W * *", "2022-12-01 00:00:00", "2022-12-27 09:00:00", "2022-11-25 09:00:00"},
+			{"0 9 25W * *", "2022-12-01 00:00:00", "2022-12-23 09:00:00", "2022-11-25 09:00:00"},
+			{"0 9 1BD * *", "2022-12-02 00:00:00", "2023-01-02 09:00:00", "2022-12-01 09:00:00"},
+			{"0 9 LBD * *", "2022-12-01 00:00:00", "2022-12-30 09:00:00", "2022-11-30 09:00:00"},
+			{"0 9 17BD * *", "2022-12-01 00:00:00", "2022-12-23 09:00:00", "2022-11-23 09:00:00"},
+			{"0 9 18BD * *", "2022-12-01 00:00:00", "2022-12-27 09:00:00", "2022-11-24 09:00:00"},
+		}
+		for _, test := range tests {
+			next, err := gron.NextTickAfter(test.expr, parse(test.ref), false)
+			if actual := next.Format(FullDateFormat); err != nil || actual != test.next {
+				t.Errorf("next of %s after %s: expected %s, got %s (%v)", test.expr, test.ref, test.next, actual, err)
+			}
+			prev, err := gron.PrevTickBefore(test.expr, parse(test.ref), false)
+			if actual := prev.Format(FullDateFormat); err != nil || actual != test.prev {
+				t.Errorf("prev of %s before %s: expected %s, got %s (%v)", test.expr, test.ref, test.prev, actual, err)
+			}
+		}
+	})
+}
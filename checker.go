@@ -17,6 +17,26 @@ type Checker interface {
 // SegmentChecker is factory implementation of Checker.
 type SegmentChecker struct {
 	ref time.Time
+	cal Calendar
+	sys CalendarSystem
+}
+
+// System gives the CalendarSystem segment values are checked against.
+func (c *SegmentChecker) System() CalendarSystem {
+	if c.sys == nil {
+		return Gregorian{}
+	}
+	return c.sys
+}
+
+// SetSystem sets the CalendarSystem segment values are checked against.
+func (c *SegmentChecker) SetSystem(sys CalendarSystem) {
+	c.sys = sys
+}
+
+// SetCalendar sets the holiday calendar consulted by business day modifiers.
+func (c *SegmentChecker) SetCalendar(cal Calendar) {
+	c.cal = cal
 }
 
 // GetRef returns the current reference time
@@ -32,12 +52,22 @@ func (c *SegmentChecker) SetRef(ref time.Time) {
 // CheckDue checks if the cron segment at given position is due.
 // It returns bool or error if any.
 func (c *SegmentChecker) CheckDue(segment string, pos int) (due bool, err error) {
-	ref, last := c.GetRef(), -1
-	val, loc := valueByPos(ref, pos), ref.Location()
-	isMonthDay, isWeekDay := pos == 3, pos == 5
+	if pos > 6 {
+		segment = strings.ReplaceAll(segment, extPrefix[pos-7], "")
+	}
+
+	ref, sys, last := c.GetRef(), c.System(), -1
+	val, isMonthDay, isWeekDay := sys.Value(ref, pos), pos == 3, pos == 5
 
 	for _, offset := range strings.Split(segment, ",") {
-		mod := (isMonthDay || isWeekDay) && strings.ContainsAny(offset, "LW#")
+		if custom, ok := modifierFor(offset, pos); ok {
+			if due, err = custom.isDue(offset, ref); due || err != nil {
+				return
+			}
+			continue
+		}
+
+		mod := (isMonthDay || isWeekDay) && strings.ContainsAny(offset, "LWB#")
 		if due, err = c.isOffsetDue(offset, val, pos); due || (!mod && err != nil) {
 			return
 		}
@@ -45,12 +75,12 @@ func (c *SegmentChecker) CheckDue(segment string, pos int) (due bool, err error)
 			continue
 		}
 		if last == -1 {
-			last = time.Date(ref.Year(), ref.Month(), 1, 0, 0, 0, 0, loc).AddDate(0, 1, 0).Add(-time.Second).Day()
+			last = sys.LastDay(ref)
 		}
-		if isMonthDay {
-			due, err = isValidMonthDay(offset, last, ref)
+		if day := sys.Value(ref, 3); isMonthDay {
+			due, err = isValidMonthDay(offset, day, last, ref, c.cal)
 		} else if isWeekDay {
-			due, err = isValidWeekDay(offset, last, ref)
+			due, err = isValidWeekDay(offset, day, last, ref)
 		}
 		if due || err != nil {
 			return due, err
@@ -65,7 +95,7 @@ func (c *SegmentChecker) isOffsetDue(offset string, val, pos int) (bool, error)
 		return true, nil
 	}
 
-	bounds, isWeekDay := boundsByPos(pos), pos == 5
+	bounds, isWeekDay := c.System().Bounds(pos), pos == 5
 	if strings.Contains(offset, "/") {
 		return inStep(val, offset, bounds)
 	}
@@ -108,6 +138,10 @@ func valueByPos(ref time.Time, pos int) (val int) {
 		val = int(ref.Weekday())
 	case 6:
 		val = ref.Year()
+	case 7:
+		_, val = ref.ISOWeek()
+	case 8:
+		val = ref.YearDay()
 	}
 	return
 }
@@ -127,6 +161,10 @@ func boundsByPos(pos int) (bounds []int) {
 		bounds = []int{0, 7}
 	case 6:
 		bounds = []int{0, 9999}
+	case 7:
+		bounds = []int{1, 53}
+	case 8:
+		bounds = []int{1, 366}
 	}
 	return
 }
@@ -0,0 +1,159 @@
+package gronx
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and waits for it to pass.
+// The SystemClock (wall clock) is used by default, FakeClock can be used in tests.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+	Sleep(d time.Duration)
+}
+
+// Timer is the part of time.Timer used with Clock.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// SystemClock is the wall clock.
+type SystemClock struct{}
+
+// Now gives current time.
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// NewTimer gives a Timer that fires after d.
+func (SystemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+// Sleep pauses current goroutine for d.
+func (SystemClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time { return t.Timer.C }
+
+// FakeClock is a Clock whose time only moves when it is advanced manually.
+// It is safe for concurrent use.
+type FakeClock struct {
+	now    time.Time
+	timers []*fakeTimer
+	mu     sync.Mutex
+}
+
+// NewFakeClock gives a FakeClock set at given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now gives current time of clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer gives a Timer that fires when clock is advanced by d.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1)}
+	c.arm(t, d)
+	return t
+}
+
+// Sleep blocks until clock is advanced by d.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.NewTimer(d).C()
+}
+
+// Advance moves the clock by d and fires the timers that are due in order.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to given time and fires the timers that are due in order.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+	c.fire()
+}
+
+// fire sends on the channels of timers that are due, in order.
+func (c *FakeClock) fire() {
+	for {
+		var due *fakeTimer
+		for _, t := range c.timers {
+			if t.active && !t.when.After(c.now) && (due == nil || t.when.Before(due.when)) {
+				due = t
+			}
+		}
+		if due == nil {
+			break
+		}
+
+		c.disarm(due)
+		select {
+		case due.c <- c.now:
+		default:
+		}
+	}
+}
+
+// Timers gives the number of timers waiting to fire.
+func (c *FakeClock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+func (c *FakeClock) arm(t *fakeTimer, d time.Duration) {
+	if !t.active {
+		c.timers = append(c.timers, t)
+	}
+	t.when, t.active = c.now.Add(d), true
+	c.fire()
+}
+
+func (c *FakeClock) disarm(t *fakeTimer) bool {
+	if !t.active {
+		return false
+	}
+	for i := range c.timers {
+		if c.timers[i] == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			break
+		}
+	}
+	t.active = false
+	return true
+}
+
+type fakeTimer struct {
+	clock  *FakeClock
+	when   time.Time
+	c      chan time.Time
+	active bool
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.clock.disarm(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	active := t.active
+	t.clock.arm(t, d)
+	return active
+}
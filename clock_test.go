@@ -0,0 +1,91 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+
+	t.Run("timers", func(t *testing.T) {
+		clock := NewFakeClock(ref)
+		t1, t2 := clock.NewTimer(time.Minute), clock.NewTimer(time.Second)
+		if clock.Timers() != 2 {
+			t.Errorf("expected 2 timers, got %d", clock.Timers())
+		}
+
+		clock.Advance(time.Second)
+		select {
+		case now := <-t2.C():
+			if !now.Equal(ref.Add(time.Second)) {
+				t.Errorf("expected %v, got %v", ref.Add(time.Second), now)
+			}
+		default:
+			t.Error("expected t2 to fire")
+		}
+
+		if !t1.Stop() || t1.Stop() {
+			t.Error("expected t1 to stop once")
+		}
+		clock.Advance(time.Hour)
+		select {
+		case <-t1.C():
+			t.Error("expected stopped t1 not to fire")
+		default:
+		}
+
+		if t1.Reset(time.Second) {
+			t.Error("expected t1 to be inactive before reset")
+		}
+		clock.Set(ref.AddDate(0, 0, 1))
+		if _, ok := <-t1.C(); !ok || clock.Timers() != 0 {
+			t.Error("expected t1 to fire after reset")
+		}
+		if !clock.Now().Equal(ref.AddDate(0, 0, 1)) {
+			t.Errorf("expected %v, got %v", ref.AddDate(0, 0, 1), clock.Now())
+		}
+	})
+
+	t.Run("sleep", func(t *testing.T) {
+		clock, done := NewFakeClock(ref), make(chan bool)
+		go func() {
+			clock.Sleep(time.Minute)
+			done <- true
+		}()
+
+		for clock.Timers() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		clock.Advance(time.Minute)
+		<-done
+	})
+
+	t.Run("gronx", func(t *testing.T) {
+		gron := New().WithClock(NewFakeClock(ref))
+		if due, _ := gron.IsDue("54 12 * * *"); !due {
+			t.Errorf("expected due on %v", ref)
+		}
+		next, _ := gron.NextTick("0 13 * * *", false)
+		prev, _ := gron.PrevTick("0 12 * * *", false)
+		if next.Format(FullDateFormat) != "2021-04-19 13:00:00" || prev.Format(FullDateFormat) != "2021-04-19 12:00:00" {
+			t.Errorf("expected 13:00 and 12:00, got %v and %v", next, prev)
+		}
+	})
+
+	t.Run("ticker", func(t *testing.T) {
+		clock := NewFakeClock(ref)
+		ticker, err := NewTicker("0 0 * * * *", TickerOption{Clock: clock, Location: time.UTC})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		defer ticker.Stop()
+
+		for _, expect := range []string{"2021-04-19 13:00:00", "2021-04-19 14:00:00"} {
+			clock.Advance(time.Hour)
+			if tick := <-ticker.C; tick.Format(FullDateFormat) != expect {
+				t.Errorf("expected %s, got %v", expect, tick)
+			}
+		}
+	})
+}
@@ -3,10 +3,12 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"time"
 
+	"github.com/adhocore/gronx"
 	"github.com/adhocore/gronx/pkg/tasker"
 )
 
@@ -14,7 +16,9 @@ var exit = os.Exit
 var tick = time.Minute
 
 var opt tasker.Option
-var v bool
+var v, diff bool
+var window time.Duration
+var stdout io.Writer = os.Stdout
 
 // Version of tasker, injected in build
 var Version = "n/a"
@@ -24,9 +28,13 @@ func init() {
 	flag.StringVar(&opt.Tz, "tz", "Local", "The timezone to use for tasks")
 	flag.StringVar(&opt.Shell, "shell", tasker.Shell()[0], "The shell to use for running tasks")
 	flag.StringVar(&opt.Out, "out", "", "The fullpath to file where output from tasks are sent to")
+	flag.StringVar(&opt.Holidays, "holidays", "", "The holidays file listing days on which tasks are skipped")
+	flag.DurationVar(&opt.Splay, "splay", 0, "The max delay of tasks to spread them across hosts (eg: 90s)")
 	flag.BoolVar(&opt.Verbose, "verbose", false, "The verbose mode outputs as much as possible")
 	flag.Int64Var(&opt.Until, "until", 0, "The timeout for task daemon in minutes")
 	flag.BoolVar(&v, "v", false, "Show version")
+	flag.BoolVar(&diff, "diff", false, "Show the runs removed and added by changing cron expr: -diff <old expr> <new expr>")
+	flag.DurationVar(&window, "window", 7*24*time.Hour, "The window from now for -diff")
 }
 
 func main() {
@@ -53,6 +61,11 @@ func mustParseOption() {
 		exit(0)
 	}
 
+	if diff {
+		exit(printDiff(flag.Args()))
+		return
+	}
+
 	if opt.File == "" {
 		flag.Usage()
 		exit(1)
@@ -63,3 +76,32 @@ func mustParseOption() {
 		exit(1)
 	}
 }
+
+// printDiff prints the diff of old and new cron exprs within window from now.
+// It returns exit code.
+func printDiff(args []string) int {
+	if len(args) != 2 {
+		log.Printf("-diff needs old and new cron expr, got %d args", len(args))
+		return 1
+	}
+
+	loc, err := time.LoadLocation(opt.Tz)
+	if err != nil {
+		log.Printf("invalid tz location: %s", opt.Tz)
+		return 1
+	}
+
+	res, err := gronx.Diff(args[0], args[1], window, time.Now().In(loc))
+	if err != nil {
+		log.Printf("can't diff cron exprs: %v", err)
+		return 1
+	}
+
+	if res.Empty() {
+		fmt.Fprintf(stdout, "no change in %s window (%d runs)\n", window, res.Kept)
+		return 0
+	}
+
+	fmt.Fprint(stdout, res)
+	return 0
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -45,3 +47,40 @@ func TestMustGetOption(t *testing.T) {
 		os.Args = old
 	})
 }
+
+func TestPrintDiff(t *testing.T) {
+	old, oldOut := os.Args, stdout
+	defer func() { os.Args, stdout, diff = old, oldOut, false }()
+
+	var code int
+	exit = func(c int) { code = c }
+
+	t.Run("diff", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		stdout = buf
+		os.Args = append(old, "-diff", "-tz", "UTC", "-window", "168h", "0 2 * * 0", "30 2 * * 1-5")
+		mustParseOption()
+		if code != 0 {
+			t.Errorf("expected code 0, got %d", code)
+		}
+		if !strings.Contains(buf.String(), "- 02:00:00 Sun (1 run)\n") || !strings.Contains(buf.String(), "+ 02:30:00 Mon-Fri (5 runs)\n") {
+			t.Errorf("unexpected diff %q", buf.String())
+		}
+	})
+
+	t.Run("no change", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		stdout = buf
+		if code = printDiff([]string{"@daily", "0 0 * * *"}); code != 0 || !strings.HasPrefix(buf.String(), "no change") {
+			t.Errorf("expected no change, got %q (code %d)", buf.String(), code)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, args := range [][]string{{"@daily"}, {"@daily", "0 25 * * *"}} {
+			if code := printDiff(args); code != 1 {
+				t.Errorf("%v: expected code 1, got %d", args, code)
+			}
+		}
+	})
+}
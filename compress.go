@@ -0,0 +1,136 @@
+package gronx
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekly slot dimensions: weekday, hour, minute and second with their bounds.
+var slotBounds = [4][2]int{{0, 6}, {0, 23}, {0, 59}, {0, 59}}
+
+// block is a cartesian product of values per dimension of weekly slots.
+type block [4][]int
+
+// Compress gives a short list of cron exprs that together fire exactly at the weekly
+// slots (weekday and time of day, in their own location) of given times, eg: the
+// ticked cells of a week grid. So the day of month and month are always `*`.
+// The list is a heuristic, not minimal: slots are folded into blocks one dimension at a
+// time for each order of dimensions, and the order giving fewest blocks wins.
+// The exprs are 6 segments only if any time has seconds.
+// It returns empty list if there are no times.
+func Compress(times []time.Time) []string {
+	slots, secs := map[[4]int]bool{}, false
+	for _, t := range times {
+		slots[[4]int{int(t.Weekday()), t.Hour(), t.Minute(), t.Second()}] = true
+		secs = secs || t.Second() > 0
+	}
+	if len(slots) == 0 {
+		return []string{}
+	}
+
+	var best []block
+	for _, order := range permutations([]int{0, 1, 2, 3}) {
+		if blocks := fold(slots, order); best == nil || len(blocks) < len(best) {
+			best = blocks
+		}
+	}
+
+	sort.Slice(best, func(i, j int) bool {
+		for d := 0; d < 4; d++ {
+			if best[i][d][0] != best[j][d][0] {
+				return best[i][d][0] < best[j][d][0]
+			}
+		}
+		return false
+	})
+
+	exprs := make([]string, 0, len(best))
+	for _, b := range best {
+		expr := strings.Join([]string{joinValues(b[2], 2), joinValues(b[1], 1), "*", "*", joinValues(b[0], 0)}, " ")
+		if secs {
+			expr = joinValues(b[3], 3) + " " + expr
+		}
+		exprs = append(exprs, expr)
+	}
+	return exprs
+}
+
+// fold merges the slots into blocks one dimension at a time in given order: the items
+// that agree on all other dimensions are merged into one with the union of values.
+func fold(slots map[[4]int]bool, order []int) []block {
+	items := make([]block, 0, len(slots))
+	for slot := range slots {
+		items = append(items, block{{slot[0]}, {slot[1]}, {slot[2]}, {slot[3]}})
+	}
+
+	for _, dim := range order {
+		merged, keys := map[string]*block{}, []string{}
+		for _, item := range items {
+			parts := make([]string, 4)
+			for d := 0; d < 4; d++ {
+				if d != dim {
+					parts[d] = joinValues(item[d], -1)
+				}
+			}
+
+			key := strings.Join(parts, " ")
+			if b, ok := merged[key]; ok {
+				b[dim] = append(b[dim], item[dim]...)
+				continue
+			}
+
+			b := item
+			b[dim] = append([]int{}, item[dim]...)
+			merged[key], keys = &b, append(keys, key)
+		}
+
+		items = items[:0]
+		for _, key := range keys {
+			b := merged[key]
+			sort.Ints(b[dim])
+			items = append(items, *b)
+		}
+	}
+	return items
+}
+
+// joinValues renders sorted values of dimension as cron segment with ranges of
+// 3 or more consecutive values, or `*` if it has all values (dim -1 for plain list).
+func joinValues(vals []int, dim int) string {
+	if dim >= 0 && len(vals) == slotBounds[dim][1]-slotBounds[dim][0]+1 {
+		return "*"
+	}
+
+	var parts []string
+	for i := 0; i < len(vals); i++ {
+		j := i
+		for j+1 < len(vals) && vals[j+1] == vals[j]+1 {
+			j++
+		}
+
+		part := strconv.Itoa(vals[i])
+		if dim >= 0 && j-i >= 2 {
+			part, i = part+"-"+strconv.Itoa(vals[j]), j
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ",")
+}
+
+// permutations gives all orderings of vals.
+func permutations(vals []int) [][]int {
+	if len(vals) <= 1 {
+		return [][]int{vals}
+	}
+
+	var perms [][]int
+	for i, val := range vals {
+		rest := append(append([]int{}, vals[:i]...), vals[i+1:]...)
+		for _, perm := range permutations(rest) {
+			perms = append(perms, append([]int{val}, perm...))
+		}
+	}
+	return perms
+}
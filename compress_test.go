@@ -0,0 +1,83 @@
+package gronx
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompress(t *testing.T) {
+	// Monday
+	monday, _ := time.Parse(FullDateFormat, "2021-04-19 00:00:00")
+	at := func(day, hour, min, sec int) time.Time {
+		return monday.AddDate(0, 0, day-1).Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second)
+	}
+
+	grid := func(days, hours []int, min int) (times []time.Time) {
+		for _, day := range days {
+			for _, hour := range hours {
+				times = append(times, at(day, hour, min, 0))
+			}
+		}
+		return
+	}
+
+	var scattered []time.Time
+	for i, hour := range []int{0, 3, 7, 9, 9, 13, 13, 13, 20, 23} {
+		for _, day := range []int{i % 7, (i * 3) % 7, 5} {
+			scattered = append(scattered, at(day+1, hour, (i*7)%60, 0))
+		}
+	}
+
+	tests := []struct {
+		name   string
+		times  []time.Time
+		expect []string
+	}{
+		{"empty", nil, []string{}},
+		{"single", []time.Time{at(0, 2, 0, 0)}, []string{"0 2 * * 0"}},
+		{"product", grid([]int{1, 2, 3, 4, 5}, []int{9, 12, 17}, 30), []string{"30 9,12,17 * * 1-5"}},
+		{"every day", grid([]int{0, 1, 2, 3, 4, 5, 6}, []int{9, 10, 11}, 0), []string{"0 9-11 * * *"}},
+		{"duplicate", append(grid([]int{1}, []int{9}, 0), at(8, 9, 0, 0)), []string{"0 9 * * 1"}},
+		{"two blocks", append(grid([]int{1, 2, 3, 4, 5}, []int{9, 17}, 0), at(6, 9, 0, 0)), []string{"0 9 * * 1-6", "0 17 * * 1-5"}},
+		{"seconds", []time.Time{at(1, 9, 0, 15), at(1, 9, 0, 45)}, []string{"15,45 0 9 * * 1"}},
+		{"scattered", scattered, []string{
+			"14 7 * * 0,3,6", "42 13 * * 0,5,6", "3 23 * * 0,3,6", "0 0 * * 1,6", "49 13 * * 1,6",
+			"7 3 * * 2,4,6", "35 13 * * 2,6", "56 20 * * 2,4,6", "21 9 * * 3,4,6", "28 9 * * 5,6",
+		}},
+	}
+
+	// expand gives the weekly slots that exprs fire at, walking a week of their ticks.
+	expand := func(exprs []string) map[[4]int]bool {
+		slots := map[[4]int]bool{}
+		for _, expr := range exprs {
+			for ref := monday; ; ref = ref.Add(time.Second) {
+				next, err := NextTickAfter(expr, ref, true)
+				if err != nil || !next.Before(monday.AddDate(0, 0, 7)) {
+					break
+				}
+				ref, slots[[4]int{int(next.Weekday()), next.Hour(), next.Minute(), next.Second()}] = next, true
+			}
+		}
+		return slots
+	}
+	slotsOf := func(times []time.Time) map[[4]int]bool {
+		slots := map[[4]int]bool{}
+		for _, tm := range times {
+			slots[[4]int{int(tm.Weekday()), tm.Hour(), tm.Minute(), tm.Second()}] = true
+		}
+		return slots
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Compress(test.times)
+			if !reflect.DeepEqual(actual, test.expect) {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+			if slots, want := expand(actual), slotsOf(test.times); !reflect.DeepEqual(slots, want) {
+				t.Errorf("%v: expected to expand to %v, got %v", actual, want, slots)
+			}
+		})
+	}
+}
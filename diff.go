@@ -0,0 +1,160 @@
+package gronx
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxDiffTicks is the max number of ticks Diff walks through in a window per expr.
+var MaxDiffTicks = 10000
+
+// ScheduleDiff is the difference of runs of two cron exprs within a window.
+type ScheduleDiff struct {
+	// Added are the runs of new expr that old expr doesn't have.
+	Added []time.Time
+	// Removed are the runs of old expr that new expr doesn't have.
+	Removed []time.Time
+	// Kept is the number of runs both exprs have.
+	Kept int
+	// Truncated is true if window had more than MaxDiffTicks ticks of either expr.
+	Truncated bool
+}
+
+// Diff gives the runs added and removed by changing oldExpr to newExpr
+// within window starting at given time (or now).
+// It returns ScheduleDiff or error if either expr is invalid.
+func Diff(oldExpr, newExpr string, window time.Duration, ref ...time.Time) (ScheduleDiff, error) {
+	return New().Diff(oldExpr, newExpr, window, ref...)
+}
+
+// Diff gives the runs added and removed by changing oldExpr to newExpr
+// within window starting at given time (or now), skipping the days excluded by Calendar if any.
+// It returns ScheduleDiff or error if either expr is invalid.
+func (g *Gronx) Diff(oldExpr, newExpr string, window time.Duration, ref ...time.Time) (ScheduleDiff, error) {
+	var diff ScheduleDiff
+	ref = append(ref, g.now())
+	until := ref[0].Add(window)
+
+	var runs [2][]time.Time
+	for i, expr := range []string{oldExpr, newExpr} {
+		sched, err := g.Compile(expr)
+		if err != nil {
+			return diff, err
+		}
+
+		var truncated bool
+		runs[i], truncated = ticksWithin(sched, ref[0], until)
+		diff.Truncated = diff.Truncated || truncated
+	}
+
+	before, after := runs[0], runs[1]
+	for len(before) > 0 || len(after) > 0 {
+		switch {
+		case len(after) == 0 || len(before) > 0 && before[0].Before(after[0]):
+			diff.Removed, before = append(diff.Removed, before[0]), before[1:]
+		case len(before) == 0 || after[0].Before(before[0]):
+			diff.Added, after = append(diff.Added, after[0]), after[1:]
+		default:
+			diff.Kept, before, after = diff.Kept+1, before[1:], after[1:]
+		}
+	}
+
+	return diff, nil
+}
+
+// ticksWithin gives the ticks of sched from start till until (both inclusive).
+// It returns ticks and true if there were more than MaxDiffTicks of them.
+func ticksWithin(sched *Schedule, start, until time.Time) ([]time.Time, bool) {
+	var ticks []time.Time
+
+	next, err := sched.NextTickAfter(start, true)
+	for err == nil && !next.After(until) {
+		if len(ticks) >= MaxDiffTicks {
+			return ticks, true
+		}
+
+		ticks = append(ticks, next)
+		next, err = sched.NextTickAfter(next, false)
+	}
+	return ticks, false
+}
+
+// Empty tells if no runs are added or removed.
+func (d ScheduleDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
+
+// String summarizes removed and added runs grouped by time of day, a line each
+// prefixed by - or + respectively, eg: `+ 02:30:00 Mon-Fri (5 runs)`.
+func (d ScheduleDiff) String() string {
+	var sb strings.Builder
+	for _, side := range []struct {
+		sign string
+		runs []time.Time
+	}{{"-", d.Removed}, {"+", d.Added}} {
+		for _, line := range groupByClock(side.runs) {
+			sb.WriteString(side.sign + " " + line + "\n")
+		}
+	}
+	if d.Truncated {
+		sb.WriteString("... (truncated)\n")
+	}
+	return sb.String()
+}
+
+// groupByClock gives lines of time of day with weekdays and number of runs on it.
+func groupByClock(runs []time.Time) []string {
+	days, counts := map[string][]bool{}, map[string]int{}
+	for _, run := range runs {
+		clock := run.Format("15:04:05")
+		if run.Nanosecond() > 0 {
+			clock = run.Format("15:04:05.000")
+		}
+		if days[clock] == nil {
+			days[clock] = make([]bool, 7)
+		}
+		days[clock][run.Weekday()] = true
+		counts[clock]++
+	}
+
+	clocks := make([]string, 0, len(days))
+	for clock := range days {
+		clocks = append(clocks, clock)
+	}
+	sort.Strings(clocks)
+
+	lines := make([]string, 0, len(clocks))
+	for _, clock := range clocks {
+		unit := "runs"
+		if counts[clock] == 1 {
+			unit = "run"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s (%d %s)", clock, weekdayRanges(days[clock]), counts[clock], unit))
+	}
+	return lines
+}
+
+// weekdayRanges renders weekdays (Sunday first) as comma separated names
+// or ranges of 3 or more consecutive days, eg: Sun,Mon-Fri.
+func weekdayRanges(on []bool) string {
+	var parts []string
+	for day := 0; day < len(on); day++ {
+		if !on[day] {
+			continue
+		}
+
+		end := day
+		for end+1 < len(on) && on[end+1] {
+			end++
+		}
+
+		name := time.Weekday(day).String()[0:3]
+		if end-day >= 2 {
+			name, day = name+"-"+time.Weekday(end).String()[0:3], end
+		}
+		parts = append(parts, name)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,76 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	// Monday
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 00:00:00")
+	week := 7*24*time.Hour - time.Second
+
+	t.Run("added and removed", func(t *testing.T) {
+		diff, err := Diff("0 2 * * 0,3", "30 2 * * 1-5", week, ref)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(diff.Removed) != 2 || len(diff.Added) != 5 || diff.Kept != 0 {
+			t.Errorf("expected 2 removed and 5 added, got %d and %d", len(diff.Removed), len(diff.Added))
+		}
+		if actual := diff.Added[0].Format(FullDateFormat); actual != "2021-04-19 02:30:00" {
+			t.Errorf("expected 2021-04-19 02:30:00, got %s", actual)
+		}
+
+		expect := "- 02:00:00 Sun,Wed (2 runs)\n+ 02:30:00 Mon-Fri (5 runs)\n"
+		if actual := diff.String(); actual != expect {
+			t.Errorf("expected %q, got %q", expect, actual)
+		}
+	})
+
+	t.Run("kept", func(t *testing.T) {
+		diff, _ := Diff("0 2 * * *", "0 2 * * 1-5", week, ref)
+		if len(diff.Removed) != 2 || len(diff.Added) != 0 || diff.Kept != 5 {
+			t.Errorf("expected 2 removed and 5 kept, got %d and %d", len(diff.Removed), diff.Kept)
+		}
+		if expect := "- 02:00:00 Sun,Sat (2 runs)\n"; diff.String() != expect {
+			t.Errorf("expected %q, got %q", expect, diff.String())
+		}
+	})
+
+	t.Run("same", func(t *testing.T) {
+		diff, _ := Diff("0 2 * * MON-FRI", "0 2 * * 1-5", week, ref)
+		if !diff.Empty() || diff.Kept != 5 || diff.String() != "" {
+			t.Errorf("expected no change, got %v", diff)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		diff, _ := Diff("* * * * * *", "*/2 * * * * *", week, ref)
+		if !diff.Truncated {
+			t.Errorf("expected truncated")
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		if _, err := Diff("0 2 * * *", "0 25 * * *", week, ref); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if _, err := Diff("@every 0s", "0 2 * * *", week, ref); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestWeekdayRanges(t *testing.T) {
+	for expect, on := range map[string][]bool{
+		"Sun-Sat":     {true, true, true, true, true, true, true},
+		"Mon,Tue":     {false, true, true, false, false, false, false},
+		"Sun,Tue-Thu": {true, false, true, true, true, false, false},
+		"":            make([]bool, 7),
+	} {
+		if actual := weekdayRanges(on); actual != expect {
+			t.Errorf("expected %q, got %q", expect, actual)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Epoch is the default reference time that @every intervals are anchored to.
+// An `@every 7m` expr is due at Epoch, Epoch+7m, Epoch+14m and so on.
+// It is not safe to change concurrently with checks, change it on init or
+// use Gronx.WithEpoch instead.
+var Epoch = time.Unix(0, 0).UTC()
+
+const everyTag = "@every"
+
+// isEvery tells if given expr is an @every interval.
+func isEvery(expr string) bool {
+	parts := strings.Fields(expr)
+	return len(parts) > 0 && strings.ToLower(parts[0]) == everyTag
+}
+
+// everySegments parses `@every <duration>` expr into its two segments.
+// It returns array or error.
+func everySegments(expr string) ([]string, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != everyTag {
+		return []string{}, errors.New("expr should be '@every <duration>'")
+	}
+
+	dur, err := parseEvery(parts[1])
+	if err != nil {
+		return []string{}, err
+	}
+
+	return []string{everyTag, dur.String()}, nil
+}
+
+func parseEvery(val string) (time.Duration, error) {
+	dur, err := time.ParseDuration(strings.ToLower(val))
+	if err != nil {
+		return 0, err
+	}
+	if dur < time.Millisecond {
+		return 0, errors.New("@every duration must be at least 1ms")
+	}
+	if dur%time.Millisecond != 0 {
+		return 0, errors.New("@every duration must be whole milliseconds")
+	}
+
+	return dur, nil
+}
+
+// Precision gives the finest resolution of interval: time.Millisecond if it has
+// fraction of second (eg: @every 250ms), time.Second otherwise.
+func Precision(dur time.Duration) time.Duration {
+	if dur%time.Second == 0 {
+		return time.Second
+	}
+	return time.Millisecond
+}
+
+// WithEpoch anchors @every intervals of Gronx to given time instead of Epoch,
+// eg: the time a task is scheduled. Zero time resets it to Epoch.
+// It returns itself for fluency.
+func (g *Gronx) WithEpoch(epoch time.Time) *Gronx {
+	g.Epoch = epoch
+	return g
+}
+
+// epoch gives the anchor of @every intervals.
+func (g *Gronx) epoch() time.Time {
+	if g.Epoch.IsZero() {
+		return Epoch
+	}
+	return g.Epoch
+}
+
+// everyDue checks if ref falls exactly on the interval anchored to epoch.
+func (g *Gronx) everyDue(dur time.Duration, ref time.Time) bool {
+	return ref.Truncate(Precision(dur)).Sub(g.epoch())%dur == 0
+}
+
+// everyTick gives next (or prev if reverse) tick of interval from ref.
+func (g *Gronx) everyTick(dur time.Duration, ref time.Time, incl, reverse bool) time.Time {
+	ref = ref.Truncate(Precision(dur))
+	rem := ref.Sub(g.epoch()) % dur
+	if rem < 0 {
+		rem += dur
+	}
+
+	if rem == 0 && incl {
+		return ref
+	}
+	if reverse {
+		if rem == 0 {
+			rem = dur
+		}
+		return ref.Add(-rem)
+	}
+
+	return ref.Add(dur - rem)
+}
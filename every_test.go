@@ -0,0 +1,158 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	gron := New()
+
+	t.Run("segments", func(t *testing.T) {
+		segs, err := Segments("@every 1h30m")
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if len(segs) != 2 || segs[0] != "@every" || segs[1] != "1h30m0s" {
+			t.Errorf("expected [@every 1h30m0s], got %v", segs)
+		}
+	})
+
+	t.Run("is valid", func(t *testing.T) {
+		for _, expr := range []string{"@every 90s", "@EVERY 7m", "  @every   1h30m", "@every 1500ms", "@every 250ms"} {
+			if !gron.IsValid(expr) {
+				t.Errorf("%s should be valid", expr)
+			}
+		}
+		for _, expr := range []string{"@every", "@every 0s", "@every 500us", "@every 1500us", "@every -1m", "@every 1x", "@every 1m 2m"} {
+			if gron.IsValid(expr) {
+				t.Errorf("%s should not be valid", expr)
+			}
+		}
+	})
+
+	t.Run("is due", func(t *testing.T) {
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:58:00")
+		if due, _ := gron.IsDue("@every 7m", ref); !due {
+			t.Errorf("@every 7m should be due on %s", ref)
+		}
+		if due, _ := gron.IsDue("@every 7m", ref.Add(time.Minute)); due {
+			t.Errorf("@every 7m should not be due on %s", ref.Add(time.Minute))
+		}
+		if due, _ := gron.IsDue("@every 7m", ref.Add(500*time.Millisecond)); !due {
+			t.Errorf("@every 7m should be due within the second of %s", ref)
+		}
+	})
+
+	t.Run("next and prev tick", func(t *testing.T) {
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:58:00")
+		tests := []struct {
+			ref, next, prev string
+			incl            bool
+		}{
+			{"2021-04-19 12:58:00", "2021-04-19 13:05:00", "2021-04-19 12:51:00", false},
+			{"2021-04-19 12:58:00", "2021-04-19 12:58:00", "2021-04-19 12:58:00", true},
+			{"2021-04-19 12:58:30", "2021-04-19 13:05:00", "2021-04-19 12:58:00", false},
+			{"2021-04-19 12:58:30", "2021-04-19 13:05:00", "2021-04-19 12:58:00", true},
+		}
+		for _, test := range tests {
+			ref, _ = time.Parse(FullDateFormat, test.ref)
+			next, _ := NextTickAfter("@every 7m", ref, test.incl)
+			if actual := next.Format(FullDateFormat); actual != test.next {
+				t.Errorf("next of %s: expected %s, got %s", test.ref, test.next, actual)
+			}
+			prev, _ := PrevTickBefore("@every 7m", ref, test.incl)
+			if actual := prev.Format(FullDateFormat); actual != test.prev {
+				t.Errorf("prev of %s: expected %s, got %s", test.ref, test.prev, actual)
+			}
+		}
+	})
+
+	t.Run("sub second", func(t *testing.T) {
+		ref, _ := time.Parse(MilliDateFormat, "2021-04-19 12:58:00.300")
+		next, err := NextTickAfter("@every 250ms", ref, false)
+		if actual := next.Format(MilliDateFormat); err != nil || actual != "2021-04-19 12:58:00.500" {
+			t.Errorf("expected 2021-04-19 12:58:00.500, got %s (err: %v)", actual, err)
+		}
+		prev, _ := PrevTickBefore("@every 250ms", ref, false)
+		if actual := prev.Format(MilliDateFormat); actual != "2021-04-19 12:58:00.250" {
+			t.Errorf("expected 2021-04-19 12:58:00.250, got %s", actual)
+		}
+		next, _ = NextTickAfter("@every 1500ms", ref, false)
+		if actual := next.Format(MilliDateFormat); actual != "2021-04-19 12:58:01.500" {
+			t.Errorf("expected 2021-04-19 12:58:01.500, got %s", actual)
+		}
+
+		for us, expect := range map[int]bool{0: true, 250000: true, 250500: true, 251000: false, 999000: false} {
+			ref := ref.Truncate(time.Second).Add(time.Duration(us) * time.Microsecond)
+			if due, _ := gron.IsDue("@every 250ms", ref); due != expect {
+				t.Errorf("@every 250ms due on %s: expected %v, got %v", ref.Format(MilliDateFormat), expect, due)
+			}
+		}
+
+		if segs, _ := Segments("@every 250ms"); segs[1] != "250ms" {
+			t.Errorf("expected 250ms, got %s", segs[1])
+		}
+		if Precision(250*time.Millisecond) != time.Millisecond || Precision(time.Minute) != time.Second {
+			t.Errorf("expected ms precision for sub second only")
+		}
+	})
+
+	t.Run("custom epoch", func(t *testing.T) {
+		old := Epoch
+		defer func() { Epoch = old }()
+
+		Epoch, _ = time.Parse(FullDateFormat, "2021-04-19 12:00:05")
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:00:00")
+		next, _ := NextTickAfter("@every 90s", ref, false)
+		if actual := next.Format(FullDateFormat); actual != "2021-04-19 12:00:05" {
+			t.Errorf("expected 2021-04-19 12:00:05, got %s", actual)
+		}
+		prev, _ := PrevTickBefore("@every 90s", ref, false)
+		if actual := prev.Format(FullDateFormat); actual != "2021-04-19 11:58:35" {
+			t.Errorf("expected 2021-04-19 11:58:35, got %s", actual)
+		}
+	})
+
+	t.Run("with epoch", func(t *testing.T) {
+		epoch, _ := time.Parse(FullDateFormat, "2021-04-19 12:00:05")
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:00:00")
+		anchored := New().WithEpoch(epoch)
+
+		next, _ := anchored.NextTickAfter("@every 90s", ref, false)
+		if actual := next.Format(FullDateFormat); actual != "2021-04-19 12:00:05" {
+			t.Errorf("expected 2021-04-19 12:00:05, got %s", actual)
+		}
+		if due, _ := anchored.IsDue("@every 90s", epoch.Add(3*time.Minute)); !due {
+			t.Errorf("expected due on 3m after epoch")
+		}
+		if sched, _ := anchored.Compile("@every 90s"); !sched.IsDue(epoch.Add(90 * time.Second)) {
+			t.Errorf("expected compiled schedule to keep epoch")
+		}
+
+		// others stay anchored to Epoch
+		next, _ = gron.NextTickAfter("@every 90s", ref, false)
+		if actual := next.Format(FullDateFormat); actual != "2021-04-19 12:01:30" {
+			t.Errorf("expected 2021-04-19 12:01:30, got %s", actual)
+		}
+		next, _ = anchored.WithEpoch(time.Time{}).NextTickAfter("@every 90s", ref, false)
+		if actual := next.Format(FullDateFormat); actual != "2021-04-19 12:01:30" {
+			t.Errorf("expected 2021-04-19 12:01:30 after reset, got %s", actual)
+		}
+	})
+
+	t.Run("batch due", func(t *testing.T) {
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:58:00")
+		for _, expr := range gron.BatchDue([]string{"@every 7m", "@every 1m", "@every 0s"}, ref) {
+			if expr.Expr == "@every 0s" {
+				if expr.Err == nil {
+					t.Errorf("%s expected error", expr.Expr)
+				}
+				continue
+			}
+			if !expr.Due || expr.Err != nil {
+				t.Errorf("%s should be due, err: %v", expr.Expr, expr.Err)
+			}
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CalendarBlocker is the Blocker of Explanation when only Calendar blocked the match.
+const CalendarBlocker = -2
+
+var segmentNames = []string{"second", "minute", "hour", "day", "month", "weekday", "year", "week", "yearday"}
+
+// Trace is the due check result of a single segment.
+type Trace struct {
+	Err     error
+	Name    string
+	Segment string
+	Pos     int
+	Value   int
+	Due     bool
+}
+
+// Explanation tells why an expr is or isn't due at a reference time.
+type Explanation struct {
+	Ref    time.Time
+	Expr   string
+	Traces []Trace
+	// Union is true if <day> and <weekday> are OR-ed instead of AND-ed,
+	// which is the case if both are specific (eg: `0 9 1 * MON`).
+	Union bool
+	// Excluded is true if the day is excluded by Calendar.
+	Excluded bool
+	// Blocker is the position of segment that blocked the match, -1 if none
+	// or CalendarBlocker if all segments match but the day is excluded.
+	Blocker int
+	Due     bool
+}
+
+// Explain traces each segment of expr against given time to tell why it is or isn't due.
+// It returns Explanation or error if any.
+func Explain(expr string, ref time.Time) (Explanation, error) {
+	return New().Explain(expr, ref)
+}
+
+// Explain traces each segment of expr against given time to tell why it is or isn't due.
+// It returns Explanation or error if any.
+func (g *Gronx) Explain(expr string, ref time.Time) (Explanation, error) {
+	exp := Explanation{Ref: ref, Expr: expr, Blocker: -1}
+	segs, err := g.segments(expr)
+	if err != nil {
+		return exp, err
+	}
+
+	g.C.SetRef(ref)
+	exp.Excluded = g.isExcluded(ref)
+	if segs[0] == everyTag {
+		dur, err := parseEvery(segs[1])
+		due := err == nil && g.everyDue(dur, ref)
+		exp.Traces = []Trace{{Err: err, Name: "interval", Segment: segs[1], Due: due}}
+		if !due {
+			exp.Blocker = 0
+		} else if exp.Excluded {
+			exp.Blocker = CalendarBlocker
+		}
+		exp.Due = due && !exp.Excluded
+		return exp, err
+	}
+
+	sys := g.system()
+	exp.Traces = make([]Trace, len(segs))
+	for pos, seg := range segs {
+		trace := Trace{Name: segmentNames[pos], Segment: seg, Pos: pos, Value: sys.Value(ref, pos), Due: true}
+		if seg != "*" && seg != "?" {
+			trace.Due, trace.Err = g.C.CheckDue(seg, pos)
+		}
+		exp.Traces[pos] = trace
+	}
+
+	if weekDaySeg, monthDaySeg := segs[5], segs[3]; weekDaySeg != "*" && weekDaySeg != "?" {
+		intersect := strings.Index(weekDaySeg, "*/") == 0 || strings.Index(monthDaySeg, "*") == 0 || monthDaySeg == "?"
+		exp.Union = !intersect
+	}
+
+	dayDue := exp.Traces[3].Due || exp.Traces[5].Due
+	for pos := len(segs) - 1; pos >= 0; pos-- {
+		trace := exp.Traces[pos]
+		if trace.Err != nil {
+			err = trace.Err
+		}
+		if trace.Due || (exp.Union && dayDue && (pos == 3 || pos == 5)) {
+			continue
+		}
+		if exp.Blocker == -1 {
+			exp.Blocker = pos
+		}
+	}
+
+	if exp.Blocker == -1 && exp.Excluded {
+		exp.Blocker = CalendarBlocker
+	}
+
+	exp.Due = exp.Blocker == -1 && err == nil
+	return exp, err
+}
+
+// String gives human readable explanation, one line per segment.
+func (e Explanation) String() string {
+	out := strings.Builder{}
+	verdict := "not due"
+	if e.Due {
+		verdict = "due"
+	}
+	fmt.Fprintf(&out, "%s is %s on %s\n", e.Expr, verdict, e.Ref.Format(FullDateFormat))
+
+	for _, trace := range e.Traces {
+		mark := "no match"
+		if trace.Due {
+			mark = "match"
+		}
+		if trace.Err != nil {
+			mark = "error: " + trace.Err.Error()
+		}
+		fmt.Fprintf(&out, "  %-8s %-12s value %-5d %s\n", trace.Name, trace.Segment, trace.Value, mark)
+	}
+
+	if e.Union {
+		out.WriteString("  day and weekday are both specific, so either of them matching is enough\n")
+	}
+	if e.Excluded {
+		out.WriteString("  the day is excluded by calendar\n")
+	}
+	if e.Blocker >= 0 && e.Blocker < len(e.Traces) {
+		fmt.Fprintf(&out, "  blocked by %s\n", e.Traces[e.Blocker].Name)
+	}
+	if e.Blocker == CalendarBlocker {
+		out.WriteString("  blocked by calendar\n")
+	}
+
+	return out.String()
+}
@@ -0,0 +1,78 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExplain(t *testing.T) {
+	gron := New()
+	for i, test := range testcases() {
+		t.Run(fmt.Sprintf("explain #%d: %s", i, test.Expr), func(t *testing.T) {
+			ref, _ := time.Parse(FullDateFormat, test.Ref)
+			exp, _ := Explain(test.Expr, ref)
+			if due, _ := gron.IsDue(test.Expr, ref); exp.Due != due {
+				t.Errorf("expected %v, got %v\n%s", due, exp.Due, exp)
+			}
+		})
+	}
+
+	t.Run("union and blocker", func(t *testing.T) {
+		tests := []struct {
+			expr, ref string
+			union     bool
+			blocker   int
+		}{
+			{"0 9 1 * MON", "2022-11-01 09:00:00", true, -1},
+			{"0 9 1 * MON", "2022-11-07 09:00:00", true, -1},
+			{"0 9 1 * MON", "2022-11-02 09:00:00", true, 5},
+			{"0 9 1 * MON", "2022-11-07 10:00:00", true, 2},
+			{"0 9 * * MON", "2022-11-01 09:00:00", false, 5},
+			{"0 9 */2 * MON", "2022-11-14 09:00:00", false, 3},
+			{"0 9 1 * *", "2022-11-01 09:30:00", false, 1},
+		}
+		for _, test := range tests {
+			ref, _ := time.Parse(FullDateFormat, test.ref)
+			exp, err := Explain(test.expr, ref)
+			if err != nil || exp.Union != test.union || exp.Blocker != test.blocker {
+				t.Errorf("%s on %s: expected union %v, blocker %d, got %v, %d\n%s", test.expr, test.ref, test.union, test.blocker, exp.Union, exp.Blocker, exp)
+			}
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		ref, _ := time.Parse(FullDateFormat, "2022-11-02 09:00:00")
+		exp, _ := Explain("0 9 1 * MON", ref)
+		str := exp.String()
+		for _, expect := range []string{"is not due on 2022-11-02 09:00:00", "either of them", "blocked by weekday"} {
+			if !strings.Contains(str, expect) {
+				t.Errorf("expected %q in:\n%s", expect, str)
+			}
+		}
+	})
+
+	t.Run("calendar and error", func(t *testing.T) {
+		ref, _ := time.Parse(FullDateFormat, "2022-11-05 09:00:00")
+		gron := New().WithCalendar(NewWeeklyCalendar(time.Saturday))
+		exp, _ := gron.Explain("@every 1h", ref)
+		if exp.Due || !exp.Excluded || !exp.Traces[0].Due || exp.Blocker != CalendarBlocker || !strings.Contains(exp.String(), "excluded by calendar") {
+			t.Errorf("expected excluded, got\n%s", exp)
+		}
+		exp, _ = gron.Explain("@every 1h", ref.Add(30*time.Minute))
+		if exp.Due || exp.Traces[0].Due || exp.Blocker != 0 {
+			t.Errorf("expected interval off tick, got\n%s", exp)
+		}
+		exp, _ = gron.Explain("0 9 * * *", ref)
+		if exp.Due || exp.Blocker != CalendarBlocker || !strings.Contains(exp.String(), "blocked by calendar") {
+			t.Errorf("expected blocked by calendar, got\n%s", exp)
+		}
+		if _, err := Explain("0 9 32 * *", ref); err == nil {
+			t.Error("expected error")
+		}
+		if _, err := Explain("0 9 *", ref); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
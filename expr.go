@@ -0,0 +1,97 @@
+package gronx
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CronExpr is a validated cron expr in its canonical form, eg: `@daily` is `0 0 0 * * *`.
+// It encodes (as text, JSON or SQL) to the canonical form, for use in configs and database records.
+type CronExpr struct {
+	expr string
+}
+
+// ParseExpr validates cron expr and gives CronExpr holding its canonical form.
+// It returns CronExpr or error if expr is invalid.
+func ParseExpr(expr string) (CronExpr, error) {
+	segs, err := Segments(expr)
+	if err != nil {
+		return CronExpr{}, err
+	}
+
+	canon := strings.Join(segs, " ")
+	if !IsValid(canon) {
+		return CronExpr{}, errors.New("invalid cron expr: " + expr)
+	}
+	return CronExpr{canon}, nil
+}
+
+// String gives the cron expr.
+func (e CronExpr) String() string {
+	return e.expr
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e CronExpr) MarshalText() ([]byte, error) {
+	return []byte(e.expr), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it validates the expr and stores its canonical form.
+func (e *CronExpr) UnmarshalText(text []byte) error {
+	expr, err := ParseExpr(string(text))
+	if err != nil {
+		return err
+	}
+	*e = expr
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the empty CronExpr is null.
+func (e CronExpr) MarshalJSON() ([]byte, error) {
+	if e.expr == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.expr)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it validates the expr and stores its canonical form.
+// The JSON null or empty string gives empty CronExpr.
+func (e *CronExpr) UnmarshalJSON(data []byte) error {
+	var text string
+	if string(data) != "null" {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	}
+	if text == "" {
+		*e = CronExpr{}
+		return nil
+	}
+	return e.UnmarshalText([]byte(text))
+}
+
+// Scan implements sql.Scanner, it validates the expr and stores its canonical form.
+// The NULL value gives empty CronExpr.
+func (e *CronExpr) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*e = CronExpr{}
+		return nil
+	case string:
+		return e.UnmarshalText([]byte(src))
+	case []byte:
+		return e.UnmarshalText(src)
+	}
+	return fmt.Errorf("can't scan %T into gronx.CronExpr", src)
+}
+
+// Value implements driver.Valuer, the empty CronExpr is NULL.
+func (e CronExpr) Value() (driver.Value, error) {
+	if e.expr == "" {
+		return nil, nil
+	}
+	return e.expr, nil
+}
@@ -0,0 +1,101 @@
+package gronx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCronExpr(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		expr, err := ParseExpr("@daily")
+		if err != nil || expr.String() != "0 0 0 * * *" {
+			t.Errorf("expected 0 0 0 * * *, got %s (%v)", expr, err)
+		}
+		for _, invalid := range []string{"", "* * *", "60 * * * *", "@every 0s"} {
+			if _, err := ParseExpr(invalid); err == nil {
+				t.Errorf("expected error for %s", invalid)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var cfg struct {
+			Cron  CronExpr `json:"cron"`
+			Other CronExpr `json:"other"`
+		}
+		if err := json.Unmarshal([]byte(`{"cron": "*/5  * * * MON", "other": null}`), &cfg); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if cfg.Cron.String() != "0 */5 * * * 1" || cfg.Other.String() != "" {
+			t.Errorf("expected canonical expr, got %+v", cfg)
+		}
+
+		out, _ := json.Marshal(cfg)
+		if string(out) != `{"cron":"0 */5 * * * 1","other":null}` {
+			t.Errorf("unexpected json %s", out)
+		}
+
+		// Batch results keep their fields
+		out, _ = json.Marshal(New().BatchDue([]string{"* * * * * *"}))
+		if string(out) != `[{"Err":null,"Expr":"* * * * * *","Due":true}]` {
+			t.Errorf("unexpected batch json %s", out)
+		}
+
+		for _, invalid := range []string{`{"cron": "* * *"}`, `{"cron": 1}`} {
+			if err := json.Unmarshal([]byte(invalid), &cfg); err == nil {
+				t.Errorf("expected error for %s", invalid)
+			}
+		}
+	})
+
+	t.Run("json zero value", func(t *testing.T) {
+		for _, in := range []string{`null`, `""`} {
+			expr, _ := ParseExpr("@hourly")
+			if err := json.Unmarshal([]byte(in), &expr); err != nil || expr != (CronExpr{}) {
+				t.Errorf("%s: expected zero value, got %s (%v)", in, expr, err)
+			}
+			out, _ := json.Marshal(expr)
+			if string(out) != "null" {
+				t.Errorf("%s: expected null, got %s", in, out)
+			}
+			if err := json.Unmarshal(out, &expr); err != nil || expr != (CronExpr{}) {
+				t.Errorf("%s: expected zero value on round trip, got %s (%v)", in, expr, err)
+			}
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var expr CronExpr
+		if err := expr.UnmarshalText([]byte("@every 90m")); err != nil || expr.String() != "@every 1h30m0s" {
+			t.Errorf("expected @every 1h30m0s, got %s (%v)", expr, err)
+		}
+		if text, _ := expr.MarshalText(); string(text) != "@every 1h30m0s" {
+			t.Errorf("expected @every 1h30m0s, got %s", text)
+		}
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		var expr CronExpr
+		for _, src := range []interface{}{"@hourly", []byte("@hourly")} {
+			if err := expr.Scan(src); err != nil || expr.String() != "0 0 * * * *" {
+				t.Errorf("expected 0 0 * * * *, got %s (%v)", expr, err)
+			}
+		}
+		if val, _ := expr.Value(); val != "0 0 * * * *" {
+			t.Errorf("expected 0 0 * * * *, got %v", val)
+		}
+
+		if err := expr.Scan(nil); err != nil || expr.String() != "" {
+			t.Errorf("expected empty expr, got %s (%v)", expr, err)
+		}
+		if val, _ := expr.Value(); val != nil {
+			t.Errorf("expected nil, got %v", val)
+		}
+
+		for _, src := range []interface{}{"* * *", 1} {
+			if err := expr.Scan(src); err == nil {
+				t.Errorf("expected error for %v", src)
+			}
+		}
+	})
+}
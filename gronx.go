@@ -43,12 +43,18 @@ func AddTag(tag, expr string) error {
 	expr = strings.Join(segs, " ")
 
 	expressions[tag] = expr
+	bumpRegistry()
 	return nil
 }
 
 // SpaceRe is regex for whitespace.
 var SpaceRe = regexp.MustCompile(`\s+`)
 var yearRe = regexp.MustCompile(`\d{4}`)
+var extRe = regexp.MustCompile(`^[WD][\d*]`)
+
+// extPrefix are the prefixes of optional segments that follow <year>:
+// W for ISO week number (pos 7) and D for day of year (pos 8).
+var extPrefix = []string{"W", "D"}
 
 func normalize(expr string) []string {
 	expr = strings.Trim(expr, " \t")
@@ -56,31 +62,89 @@ func normalize(expr string) []string {
 		expr = e
 	}
 
-	expr = SpaceRe.ReplaceAllString(expr, " ")
-	expr = literals.Replace(strings.ToUpper(expr))
+	fields := strings.Split(SpaceRe.ReplaceAllString(expr, " "), " ")
+	for i, field := range fields {
+		fields[i] = ReplaceFieldNames(field)
+	}
+	expr = literals.Replace(strings.ToUpper(strings.Join(fields, " ")))
 
 	return strings.Split(strings.ReplaceAll(expr, "  ", " "), " ")
 }
 
 // Gronx is the main program.
 type Gronx struct {
-	C Checker
+	C      Checker
+	Cal    Calendar
+	Clock  Clock
+	Cache  *Cache
+	Epoch  time.Time
+	search *search
 }
 
 // New initializes Gronx with factory defaults.
 func New() *Gronx {
-	return &Gronx{&SegmentChecker{}}
+	return &Gronx{C: &SegmentChecker{}}
+}
+
+// WithCalendar attaches a Calendar whose excluded days are never due.
+// The business day modifiers (`W`, `BD`) of checker also consult it.
+// It returns itself for fluency.
+func (g *Gronx) WithCalendar(cal Calendar) *Gronx {
+	g.Cal = cal
+	if c, ok := g.C.(interface{ SetCalendar(Calendar) }); ok {
+		c.SetCalendar(cal)
+	}
+	return g
+}
+
+// WithSystem sets the CalendarSystem segment values are checked against, eg: Fiscal or Retail.
+// It returns itself for fluency.
+func (g *Gronx) WithSystem(sys CalendarSystem) *Gronx {
+	if c, ok := g.C.(interface{ SetSystem(CalendarSystem) }); ok {
+		c.SetSystem(sys)
+	}
+	return g
+}
+
+// clone gives a copy of Gronx that does not share the reference time of checker.
+func (g *Gronx) clone() *Gronx {
+	if c, ok := g.C.(*SegmentChecker); ok {
+		return &Gronx{C: &SegmentChecker{ref: c.ref, cal: c.cal, sys: c.sys}, Cal: g.Cal, Clock: g.Clock, Cache: g.Cache, Epoch: g.Epoch, search: g.search}
+	}
+	return &Gronx{C: g.C, Cal: g.Cal, Clock: g.Clock, Cache: g.Cache, Epoch: g.Epoch, search: g.search}
+}
+
+// WithClock sets the Clock that tells now when reference time is not given.
+// It returns itself for fluency.
+func (g *Gronx) WithClock(clock Clock) *Gronx {
+	g.Clock = clock
+	return g
+}
+
+func (g *Gronx) now() time.Time {
+	if g.Clock == nil {
+		return time.Now()
+	}
+	return g.Clock.Now()
+}
+
+func (g *Gronx) system() CalendarSystem {
+	return systemOf(g.C)
 }
 
-// IsDue checks if cron expression is due for given reference time (or now).
+func (g *Gronx) isExcluded(ref time.Time) bool {
+	return g.Cal != nil && g.Cal.IsExcluded(ref)
+}
+
+// IsDue checks if cron expression is due for given reference time (or now as per Clock).
 // It returns bool or error if any.
 func (g *Gronx) IsDue(expr string, ref ...time.Time) (bool, error) {
 	if len(ref) == 0 {
-		ref = append(ref, time.Now())
+		ref = append(ref, g.now())
 	}
 	g.C.SetRef(ref[0])
 
-	segs, err := Segments(expr)
+	segs, err := g.segments(expr)
 	if err != nil {
 		return false, err
 	}
@@ -88,16 +152,27 @@ func (g *Gronx) IsDue(expr string, ref ...time.Time) (bool, error) {
 	return g.SegmentsDue(segs)
 }
 
-func (g *Gronx) isDue(expr string, ref time.Time) bool {
-	due, err := g.IsDue(expr, ref)
-	return err == nil && due
+func (g *Gronx) segmentsDue(segs []string, ref time.Time) (bool, error) {
+	g.C.SetRef(ref)
+	return g.SegmentsDue(segs)
 }
 
 // Segments splits expr into array array of cron parts.
 // If expression contains 5 parts or 6th part is year like, it prepends a second.
+// If expression ends with ISO week (eg: W1-W26) or day of year (eg: D100) parts,
+// it gives 9 parts with <year> defaulting to * and missing one to W* or D*.
+// An `@every <duration>` expr gives two parts: the tag and canonical duration.
 // It returns array or error.
 func Segments(expr string) ([]string, error) {
-	segs := normalize(expr)
+	if isEvery(expr) {
+		return everySegments(expr)
+	}
+
+	segs, ext, err := extensions(normalize(expr))
+	if err != nil {
+		return []string{}, err
+	}
+
 	slen := len(segs)
 	if slen < 5 || slen > 7 {
 		return []string{}, errors.New("expr should contain 5-7 segments separated by space")
@@ -109,12 +184,50 @@ func Segments(expr string) ([]string, error) {
 		segs = append([]string{"0"}, segs...)
 	}
 
+	if len(ext) > 0 {
+		if len(segs) == 6 {
+			segs = append(segs, "*")
+		}
+		segs = append(segs, ext...)
+	}
+
 	return segs, nil
 }
 
+// extensions pops the trailing ISO week and day of year parts from segs.
+// It returns remaining segs, extension segs (if any) or error.
+func extensions(segs []string) ([]string, []string, error) {
+	var ext []string
+	for len(segs) > 0 && extRe.MatchString(segs[len(segs)-1]) {
+		if len(ext) == 0 {
+			ext = []string{extPrefix[0] + "*", extPrefix[1] + "*"}
+		}
+
+		seg, pos := segs[len(segs)-1], 0
+		if seg[0:1] == extPrefix[1] {
+			pos = 1
+		}
+		if ext[pos] != extPrefix[pos]+"*" {
+			return segs, ext, errors.New("duplicate segment: " + seg)
+		}
+
+		ext[pos], segs = seg, segs[0:len(segs)-1]
+	}
+
+	return segs, ext, nil
+}
+
 // SegmentsDue checks if all cron parts are due.
 // It returns bool. You should use IsDue(expr) instead.
 func (g *Gronx) SegmentsDue(segs []string) (bool, error) {
+	if len(segs) == 2 && segs[0] == everyTag {
+		dur, err := parseEvery(segs[1])
+		if err != nil {
+			return false, err
+		}
+		return g.everyDue(dur, g.C.GetRef()) && !g.isExcluded(g.C.GetRef()), nil
+	}
+
 	skipMonthDayCheck := false
 	for i := 0; i < len(segs); i++ {
 		pos := len(segs) - 1 - i
@@ -156,12 +269,17 @@ func (g *Gronx) SegmentsDue(segs []string) (bool, error) {
 		}
 	}
 
-	return true, nil
+	return !g.isExcluded(g.C.GetRef()), nil
 }
 
 // IsValid checks if cron expression is valid.
 // It returns bool.
-func (g *Gronx) IsValid(expr string) bool { return IsValid(expr) }
+func (g *Gronx) IsValid(expr string) bool {
+	if g.Cache != nil {
+		return g.Cache.IsValid(expr)
+	}
+	return IsValid(expr)
+}
 
 // checker for validity
 var checker = &SegmentChecker{ref: time.Now()}
@@ -170,8 +288,13 @@ var checker = &SegmentChecker{ref: time.Now()}
 // It returns bool.
 func IsValid(expr string) bool {
 	segs, err := Segments(expr)
-	if err != nil {
-		return false
+	return err == nil && validSegments(segs)
+}
+
+// validSegments checks if all cron parts are valid.
+func validSegments(segs []string) bool {
+	if segs[0] == everyTag {
+		return true
 	}
 
 	for pos, seg := range segs {
@@ -34,6 +34,7 @@ func TestNormalize(t *testing.T) {
 		"* * * * * 2021":        "* * * * * 2021",
 		"@hourly":               "0 * * * *",
 		"0 0 JAN,feb * sun,MON": "0 0 1,2 * 0,1",
+		"0 9 * * mon w*/2":      "0 9 * * 1 W*/2",
 	}
 
 	for expr, expect := range tests {
@@ -175,8 +176,8 @@ func TestIsDue(t *testing.T) {
 }
 
 func TestValueByPos(t *testing.T) {
-	t.Run("valueByPos 7", func(t *testing.T) {
-		if actual := valueByPos(time.Now(), 7); actual != 0 {
+	t.Run("valueByPos 9", func(t *testing.T) {
+		if actual := valueByPos(time.Now(), 9); actual != 0 {
 			t.Errorf("expected 0, got %v", actual)
 		}
 	})
@@ -238,6 +239,11 @@ func testcases() []Case {
 		{"0 0 28W * *", "2011-07-01 00:00:00", false, "2011-07-28 00:00:00"},
 		{"0 0 30W * *", "2011-07-01 00:00:00", false, "2011-07-29 00:00:00"},
 		// {"0 0 31W * *", "2011-07-01 00:00:00", false, "2011-07-29 00:00:00"},
+		{"0 0 1BD * *", "2011-05-01 00:00:00", false, "2011-05-02 00:00:00"},
+		{"0 0 3BD * *", "2011-07-01 00:00:00", false, "2011-07-05 00:00:00"},
+		{"0 0 3BD * *", "2011-07-05 00:00:00", true, "2011-08-03 00:00:00"},
+		{"0 0 LBD * *", "2011-07-01 00:00:00", false, "2011-07-29 00:00:00"},
+		{"0 0 LBD * *", "2011-06-30 00:00:00", true, "2011-07-29 00:00:00"},
 		{"* * * * * 2012", "2011-05-01 00:00:00", false, "2012-01-01 00:00:00"},
 		{"* * * * 5L", "2011-07-01 00:00:00", false, "2011-07-29 00:00:00"},
 		{"* * * * 6L", "2011-07-01 00:00:00", false, "2011-07-30 00:00:00"},
@@ -253,6 +259,7 @@ func testcases() []Case {
 		{"5/0 * * * *", "2021-04-19 12:54:00", false, "2018-08-13 00:25:00"},
 		{"5/20 * * * *", "2018-08-13 00:24:00", false, "2018-08-13 00:25:00"},
 		{"5/20 * * * *", "2018-08-13 00:45:00", true, "2018-08-13 01:05:00"},
+		{"0 0 1 1 * 2007/15", "2021-04-19 12:54:00", false, "2022-01-01 00:00:00"},
 		{"5-11/4 * * * *", "2018-08-13 00:03:00", false, "2018-08-13 00:05:00"},
 		{"0 0 L * 0", "2011-06-15 23:09:00", false, "2011-06-19 00:00:00"},
 		{"3-59/15 6-12 */15 1 2-5", "2017-01-08 00:00:00", false, "2017-01-31 06:03:00"},
@@ -274,6 +281,14 @@ func testcases() []Case {
 		{"30 9 L */3 *", "2023-05-01 09:30:00", false, "2023-07-31 09:30:00"},
 		{"0 * * * * * */2", "2019-05-01 09:30:00", false, "2020-01-01 00:00:00"},
 		{"0/4 * * * *", "2019-05-01 09:31:00", false, "2019-05-01 09:32:00"},
+		{"0 0 31 * *", "2022-02-01 00:00:00", false, "2022-03-31 00:00:00"},
+		{"0 0 * * 1 W*/2", "2021-01-04 00:00:00", true, "2021-01-18 00:00:00"},
+		{"0 0 * * 1 W2/2", "2021-01-05 00:00:00", false, "2021-01-11 00:00:00"},
+		{"0 0 * * MON 2022 W2/2", "2021-06-01 00:00:00", false, "2022-01-10 00:00:00"},
+		{"0 0 * * * W1-W2,W52", "2021-01-20 00:00:00", false, "2021-12-27 00:00:00"},
+		{"0 0 * * * D100", "2021-01-01 00:00:00", false, "2021-04-10 00:00:00"},
+		{"0 0 * * * D366", "2021-01-01 00:00:00", false, "2024-12-31 00:00:00"},
+		{"0 0 * * * D1-D5/2 W53", "2019-01-01 00:00:00", false, "2021-01-01 00:00:00"},
 	}
 }
 
@@ -299,5 +314,33 @@ func errcases() []Case {
 		{"* * * * * 1#Z", "", false, ""},
 		{"* * W * L", "", false, ""},
 		{"* * 15 * 1#Z", "", false, ""},
+		{"* * 0BD * *", "2011-07-01 00:00:00", false, ""},
+		{"* * 24BD * *", "2011-07-01 00:00:00", false, ""},
+		{"* * ZBD * *", "2011-07-01 00:00:00", false, ""},
+		{"* * * * 1BD", "2011-07-01 00:00:00", false, ""},
+		{"* * * * * W54", "2011-07-01 00:00:00", false, ""},
+		{"* * * * * D0", "2011-07-01 00:00:00", false, ""},
+		{"* * * * * W1 W2", "2011-07-01 00:00:00", false, ""},
+		{"* * * * * * * * W1", "2011-07-01 00:00:00", false, ""},
+	}
+}
+
+func TestSegmentsExtensions(t *testing.T) {
+	tests := map[string]string{
+		"0 9 * * 1 W*/2":        "0 0 9 * * 1 * W*/2 D*",
+		"0 9 * * 1 2022 D100":   "0 0 9 * * 1 2022 W* D100",
+		"* 0 9 * * 1 D1 W1-W26": "* 0 9 * * 1 * W1-W26 D1",
+	}
+
+	for expr, expect := range tests {
+		t.Run("segments "+expr, func(t *testing.T) {
+			segs, err := Segments(expr)
+			if actual := strings.Join(segs, " "); err != nil || actual != expect {
+				t.Errorf("expected %v, got %v (%v)", expect, actual, err)
+			}
+			if !IsValid(strings.Join(segs, " ")) {
+				t.Errorf("canonical %v should be valid", segs)
+			}
+		})
 	}
 }
@@ -0,0 +1,123 @@
+package gronxtest
+
+import (
+	"testing"
+	"time"
+)
+
+// Impl is the cron implementation under test, eg: gronx.New() or a wrapper of it.
+type Impl interface {
+	IsValid(expr string) bool
+	IsDue(expr string, ref ...time.Time) (bool, error)
+	NextTickAfter(expr string, start time.Time, inclRefTime bool) (time.Time, error)
+	PrevTickBefore(expr string, start time.Time, inclRefTime bool) (time.Time, error)
+}
+
+// CheckInvalid asserts that impl rejects the invalid expr.
+// It returns false if it does not.
+func CheckInvalid(t testing.TB, impl Impl, expr string) bool {
+	t.Helper()
+	if impl.IsValid(expr) {
+		t.Errorf("%q: expected invalid", expr)
+		return false
+	}
+	return true
+}
+
+// CheckIsDue asserts that impl agrees with Oracle if expr is due at each ref.
+// It returns false if it does not.
+func CheckIsDue(t testing.TB, impl Impl, expr string, refs ...time.Time) bool {
+	t.Helper()
+	oracle, err := NewOracle(expr)
+	if err != nil {
+		t.Errorf("%q: oracle error: %v", expr, err)
+		return false
+	}
+
+	ok := true
+	for _, ref := range refs {
+		due, err := impl.IsDue(expr, ref)
+		if expect := oracle.IsDue(ref); err != nil || due != expect {
+			t.Errorf("%q: expected due=%v on %s, got %v (err: %v)", expr, expect, ref.Format(time.RFC3339), due, err)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// CheckNextTickAfter asserts that impl agrees with Oracle on next tick of expr after start.
+// It returns false if it does not.
+func CheckNextTickAfter(t testing.TB, impl Impl, expr string, start time.Time, inclRefTime bool) bool {
+	t.Helper()
+	return checkTick(t, impl.NextTickAfter, expr, start, inclRefTime, false)
+}
+
+// CheckPrevTickBefore asserts that impl agrees with Oracle on prev tick of expr before start.
+// It returns false if it does not.
+func CheckPrevTickBefore(t testing.TB, impl Impl, expr string, start time.Time, inclRefTime bool) bool {
+	t.Helper()
+	return checkTick(t, impl.PrevTickBefore, expr, start, inclRefTime, true)
+}
+
+type tickFunc func(expr string, start time.Time, inclRefTime bool) (time.Time, error)
+
+func checkTick(t testing.TB, tick tickFunc, expr string, start time.Time, incl, reverse bool) bool {
+	t.Helper()
+	oracle, err := NewOracle(expr)
+	if err != nil {
+		t.Errorf("%q: oracle error: %v", expr, err)
+		return false
+	}
+
+	name, expect, found := "next", time.Time{}, false
+	if reverse {
+		name = "prev"
+		expect, found = oracle.PrevTickBefore(start, incl)
+	} else {
+		expect, found = oracle.NextTickAfter(start, incl)
+	}
+
+	actual, err := tick(expr, start, incl)
+	actual = actual.Truncate(time.Second)
+	switch {
+	case found && (err != nil || !actual.Equal(expect)):
+		t.Errorf("%q: expected %s tick of %s to be %s, got %s (err: %v)", expr, name,
+			start.Format(time.RFC3339), expect.Format(time.RFC3339), actual.Format(time.RFC3339), err)
+		return false
+	case !found && err == nil && inHorizon(actual, start):
+		t.Errorf("%q: expected no %s tick of %s, got %s", expr, name, start.Format(time.RFC3339), actual.Format(time.RFC3339))
+		return false
+	}
+	return true
+}
+
+// inHorizon tells if ref is within Horizon years of start.
+func inHorizon(ref, start time.Time) bool {
+	return ref.Before(start.AddDate(Horizon, 0, 0)) && ref.After(start.AddDate(-Horizon, 0, 0))
+}
+
+// Check asserts that impl agrees with Oracle for n random valid exprs (on IsDue, NextTickAfter
+// and PrevTickBefore at random times) and rejects n random invalid exprs.
+// It returns false if it does not.
+func Check(t testing.TB, impl Impl, gen *Generator, n int) bool {
+	t.Helper()
+	ok := true
+	for i := 0; i < n; i++ {
+		expr, ref := gen.Valid(), gen.Time()
+		next, _ := NewOracle(expr)
+		due := ref
+		if next != nil {
+			// Check around a due time too, random times are seldom due
+			if tick, found := next.NextTickAfter(ref, false); found {
+				due = tick
+			}
+		}
+
+		ok = CheckIsDue(t, impl, expr, ref, due, due.Add(time.Second)) && ok
+		ok = CheckNextTickAfter(t, impl, expr, ref, gen.chance(50)) && ok
+		ok = CheckPrevTickBefore(t, impl, expr, ref, gen.chance(50)) && ok
+		ok = CheckNextTickAfter(t, impl, expr, due, true) && ok
+		ok = CheckInvalid(t, impl, gen.Invalid()) && ok
+	}
+	return ok
+}
@@ -0,0 +1,191 @@
+// Package gronxtest provides a random cron expr generator and a slow but obviously
+// correct reference implementation (oracle) to property test gronx or its wrappers.
+package gronxtest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Generator generates random cron exprs across the syntax supported by gronx.
+type Generator struct {
+	Rand *rand.Rand
+}
+
+// NewGenerator gives a Generator seeded with given seed (so the exprs are reproducible).
+func NewGenerator(seed int64) *Generator {
+	return &Generator{Rand: rand.New(rand.NewSource(seed))}
+}
+
+var tags = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@hourly", "@always",
+	"@5minutes", "@10minutes", "@15minutes", "@30minutes", "@everysecond"}
+
+var monthNames = []string{"", "JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+var weekNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// field is the bounds of a segment.
+type field struct {
+	pos, min, max int
+}
+
+var (
+	secondField  = field{0, 0, 59}
+	minuteField  = field{1, 0, 59}
+	hourField    = field{2, 0, 23}
+	dayField     = field{3, 1, 31}
+	monthField   = field{4, 1, 12}
+	weekdayField = field{5, 0, 6}
+	yearField    = field{6, 2000, 2040}
+	weekField    = field{7, 1, 53}
+	yearDayField = field{8, 1, 366}
+)
+
+func (g *Generator) between(min, max int) int {
+	return min + g.Rand.Intn(max-min+1)
+}
+
+func (g *Generator) chance(percent int) bool {
+	return g.Rand.Intn(100) < percent
+}
+
+// Valid gives a random valid cron expr.
+func (g *Generator) Valid() string {
+	switch n := g.Rand.Intn(100); {
+	case n < 5:
+		return tags[g.Rand.Intn(len(tags))]
+	case n < 10:
+		unit := []time.Duration{time.Second, time.Minute, time.Hour}[g.Rand.Intn(3)]
+		return fmt.Sprintf("@every %s", time.Duration(g.between(1, 90))*unit)
+	}
+
+	segs := []string{}
+	// <year> without <second> must have 4 digits to be told apart, so give both
+	year := g.chance(20)
+	if year || g.chance(30) {
+		segs = append(segs, g.segment(secondField))
+	}
+	segs = append(segs, g.segment(minuteField), g.segment(hourField), g.segment(dayField),
+		g.segment(monthField), g.segment(weekdayField))
+	if year {
+		segs = append(segs, g.segment(yearField))
+	}
+	if g.chance(10) {
+		if g.chance(50) {
+			segs = append(segs, "W"+g.segment(weekField))
+		} else {
+			segs = append(segs, "D"+g.segment(yearDayField))
+		}
+	}
+
+	return strings.Join(segs, " ")
+}
+
+// segment gives a random segment for field.
+func (g *Generator) segment(f field) string {
+	if g.chance(35) {
+		if (f.pos == 3 || f.pos == 5) && g.chance(20) {
+			return "?"
+		}
+		return "*"
+	}
+
+	parts := make([]string, g.between(1, 3))
+	for i := range parts {
+		parts[i] = g.part(f)
+	}
+	return strings.Join(parts, ",")
+}
+
+// part gives a random part of a segment for field.
+func (g *Generator) part(f field) string {
+	if f.pos == 3 && g.chance(15) {
+		return []string{"L", fmt.Sprintf("%dW", g.between(1, 31)), fmt.Sprintf("%dBD", g.between(1, 23)), "LBD"}[g.Rand.Intn(4)]
+	}
+	if f.pos == 5 && g.chance(15) {
+		if g.chance(50) {
+			return fmt.Sprintf("%dL", g.between(0, 7))
+		}
+		return fmt.Sprintf("%d#%d", g.between(0, 7), g.between(1, 5))
+	}
+
+	a, b := g.between(f.min, f.max), g.between(f.min, f.max)
+	if a > b {
+		a, b = b, a
+	}
+	step := g.between(1, (f.max-f.min)/2+1)
+
+	switch n := g.Rand.Intn(100); {
+	case n < 40:
+		return g.value(f, a)
+	case n < 60:
+		return g.value(f, a) + "-" + g.value(f, b)
+	case n < 75:
+		return fmt.Sprintf("*/%d", step)
+	case n < 85:
+		return fmt.Sprintf("%d-%d/%d", a, b, step)
+	case n < 95:
+		return fmt.Sprintf("%d/%d", a, step)
+	}
+	if f.pos == 5 {
+		// 7 is also Sunday
+		return "7"
+	}
+	return g.value(f, a)
+}
+
+// value gives val as is or as name for month and weekday.
+func (g *Generator) value(f field, val int) string {
+	if f.pos == 4 && g.chance(30) {
+		return monthNames[val]
+	}
+	if f.pos == 5 && g.chance(30) {
+		return weekNames[val]
+	}
+	return fmt.Sprint(val)
+}
+
+// Invalid gives a random invalid cron expr.
+func (g *Generator) Invalid() string {
+	segs := strings.Split(g.fields(), " ")
+	if g.chance(20) {
+		// wrong number of segments
+		if g.chance(50) {
+			return strings.Join(segs[0:g.between(1, 4)], " ")
+		}
+		return strings.Join(append(segs, "*", "*", "*"), " ")
+	}
+
+	fields := []field{minuteField, hourField, dayField, monthField, weekdayField}
+	pos := g.Rand.Intn(len(fields))
+	f := fields[pos]
+
+	switch g.Rand.Intn(4) {
+	case 0:
+		segs[pos] = fmt.Sprint(f.max + g.between(2, 9))
+	case 1:
+		segs[pos] = fmt.Sprintf("%d-%d", f.max, f.min)
+	case 2:
+		segs[pos] = "*/0"
+	default:
+		segs[pos] = []string{"X", "1-", "/5", "A-B", "5#"}[g.Rand.Intn(5)]
+	}
+	return strings.Join(segs, " ")
+}
+
+// fields gives random 5 segments without modifiers.
+func (g *Generator) fields() string {
+	segs := []string{}
+	for _, f := range []field{minuteField, hourField, dayField, monthField, weekdayField} {
+		segs = append(segs, fmt.Sprint(g.between(f.min, f.max)))
+	}
+	return strings.Join(segs, " ")
+}
+
+// Time gives a random time (whole seconds in UTC) between 2000 and 2040.
+func (g *Generator) Time() time.Time {
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	until := time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	return time.Unix(from+g.Rand.Int63n(until-from), 0).UTC()
+}
@@ -0,0 +1,71 @@
+package gronxtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adhocore/gronx"
+)
+
+func TestOracle(t *testing.T) {
+	ref := time.Date(2021, 4, 19, 12, 54, 30, 0, time.UTC)
+	tests := []struct {
+		expr, next, prev string
+	}{
+		{"*/5 * * * *", "2021-04-19 12:55:00", "2021-04-19 12:50:00"},
+		{"0 9 * * MON-FRI", "2021-04-20 09:00:00", "2021-04-19 09:00:00"},
+		{"0 0 L * *", "2021-04-30 00:00:00", "2021-03-31 00:00:00"},
+		{"0 0 1W * *", "2021-05-03 00:00:00", "2021-04-01 00:00:00"},
+		{"0 0 * * 5L", "2021-04-30 00:00:00", "2021-03-26 00:00:00"},
+		{"0 0 * * 1#3", "2021-05-17 00:00:00", "2021-04-19 00:00:00"},
+		{"0 0 3BD * *", "2021-05-05 00:00:00", "2021-04-05 00:00:00"},
+		{"0 0 13 * FRI", "2021-04-23 00:00:00", "2021-04-16 00:00:00"},
+		{"0 0 * * * W1", "2022-01-03 00:00:00", "2021-01-10 00:00:00"},
+		{"@every 1h", "2021-04-19 13:00:00", "2021-04-19 12:00:00"},
+	}
+
+	for _, test := range tests {
+		oracle, err := NewOracle(test.expr)
+		if err != nil {
+			t.Fatalf("%s: expected nil, got %v", test.expr, err)
+		}
+
+		next, _ := oracle.NextTickAfter(ref, false)
+		prev, _ := oracle.PrevTickBefore(ref, false)
+		if actual := next.Format(gronx.FullDateFormat); actual != test.next {
+			t.Errorf("%s: expected next %s, got %s", test.expr, test.next, actual)
+		}
+		if actual := prev.Format(gronx.FullDateFormat); actual != test.prev {
+			t.Errorf("%s: expected prev %s, got %s", test.expr, test.prev, actual)
+		}
+	}
+
+	for _, expr := range []string{"* * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "* * 40BD * *"} {
+		if _, err := NewOracle(expr); err == nil {
+			t.Errorf("%s: expected error", expr)
+		}
+	}
+	if oracle, _ := NewOracle("0 0 31 2 *"); oracle != nil {
+		if _, ok := oracle.NextTickAfter(ref, false); ok {
+			t.Error("expected no tick for Feb 31")
+		}
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	gen := NewGenerator(1)
+	for i := 0; i < 500; i++ {
+		if expr := gen.Valid(); !gronx.IsValid(expr) {
+			t.Errorf("%q: expected valid", expr)
+		} else if _, err := NewOracle(expr); err != nil {
+			t.Errorf("%q: oracle error: %v", expr, err)
+		}
+		if expr := gen.Invalid(); gronx.IsValid(expr) {
+			t.Errorf("%q: expected invalid", expr)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	Check(t, gronx.New(), NewGenerator(42), 200)
+}
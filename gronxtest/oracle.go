@@ -0,0 +1,404 @@
+package gronxtest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Horizon is how far (in years) the Oracle looks for next or prev tick.
+var Horizon = 50
+
+var oracleTags = map[string]string{
+	"@yearly": "0 0 1 1 *", "@annually": "0 0 1 1 *", "@monthly": "0 0 1 * *", "@weekly": "0 0 * * 0",
+	"@daily": "0 0 * * *", "@hourly": "0 * * * *", "@always": "* * * * *", "@5minutes": "*/5 * * * *",
+	"@10minutes": "*/10 * * * *", "@15minutes": "*/15 * * * *", "@30minutes": "0,30 * * * *",
+	"@everysecond": "* * * * * *",
+}
+
+var extRe, yearRe = regexp.MustCompile(`^[WD][\d*]`), regexp.MustCompile(`\d{4}`)
+
+var oracleBounds = [9][2]int{{0, 59}, {0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}, {0, 9999}, {1, 53}, {1, 366}}
+
+// Oracle is a slow but obviously correct cron expr matcher. It checks each segment
+// against a time by plain enumeration, and finds next or prev tick by visiting every
+// second (skipping only the years, months, days, hours and minutes that can't match).
+// It is not safe for concurrent use.
+type Oracle struct {
+	Expr  string
+	segs  [9]string
+	every int64
+	cache map[string]map[int]bool
+}
+
+// NewOracle parses cron expr for Oracle.
+// It returns Oracle or error if expr is invalid.
+func NewOracle(expr string) (*Oracle, error) {
+	o := &Oracle{Expr: expr, cache: map[string]map[int]bool{}}
+	expr = strings.TrimSpace(expr)
+	if tag, ok := oracleTags[strings.ToLower(expr)]; ok {
+		expr = tag
+	}
+
+	fields := strings.Fields(strings.ToUpper(expr))
+	if len(fields) == 2 && fields[0] == "@EVERY" {
+		dur, err := time.ParseDuration(strings.ToLower(fields[1]))
+		if err != nil || dur < time.Second || dur%time.Second != 0 {
+			return nil, errors.New("invalid interval: " + fields[1])
+		}
+		o.every = int64(dur / time.Second)
+		return o, nil
+	}
+
+	for i := range o.segs {
+		o.segs[i] = "*"
+	}
+	for len(fields) > 0 {
+		last := fields[len(fields)-1]
+		if !extRe.MatchString(last) {
+			break
+		}
+		if last[0] == 'W' {
+			o.segs[7] = strings.ReplaceAll(last, "W", "")
+		} else {
+			o.segs[8] = strings.ReplaceAll(last, "D", "")
+		}
+		fields = fields[0 : len(fields)-1]
+	}
+
+	switch {
+	case len(fields) == 5:
+		fields = append([]string{"0"}, fields...)
+	case len(fields) == 6 && yearRe.MatchString(fields[5]):
+		fields = append([]string{"0"}, fields...)
+	case len(fields) < 5 || len(fields) > 7:
+		return nil, errors.New("expr should contain 5-7 segments")
+	}
+	copy(o.segs[:], fields)
+
+	names := map[int][]string{4: monthNames, 5: weekNames}
+	for pos, seg := range o.segs {
+		for val, name := range names[pos] {
+			if name != "" {
+				seg = strings.ReplaceAll(seg, name, strconv.Itoa(val))
+			}
+		}
+		o.segs[pos] = seg
+	}
+
+	// Validate all values of all segments once
+	probe := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for pos := range o.segs {
+		if _, err := o.match(pos, probe); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
+// IsDue checks if expr is due at ref (ignoring sub seconds).
+func (o *Oracle) IsDue(ref time.Time) bool {
+	if o.every > 0 {
+		return ref.Unix()%o.every == 0
+	}
+	return o.dateDue(ref) && o.due(2, ref) && o.due(1, ref) && o.due(0, ref)
+}
+
+// NextTickAfter gives the first second after start (or at start if incl) that is due.
+// It returns false if there is none within Horizon years.
+func (o *Oracle) NextTickAfter(start time.Time, incl bool) (time.Time, bool) {
+	return o.walk(start, incl, false)
+}
+
+// PrevTickBefore gives the last second before start (or at start if incl) that is due.
+// It returns false if there is none within Horizon years.
+func (o *Oracle) PrevTickBefore(start time.Time, incl bool) (time.Time, bool) {
+	return o.walk(start, incl, true)
+}
+
+func (o *Oracle) walk(start time.Time, incl, reverse bool) (time.Time, bool) {
+	ref, loc := start.Truncate(time.Second), start.Location()
+	if !incl {
+		ref = o.step(ref, reverse)
+	}
+
+	edge := start.AddDate(Horizon, 0, 0)
+	if reverse {
+		edge = start.AddDate(-Horizon, 0, 0)
+	}
+
+	for reverse && !ref.Before(edge) || !reverse && !ref.After(edge) {
+		y, m, d := ref.Date()
+		h, i, _ := ref.Clock()
+
+		// Skip the units that can't match as a whole, else go second by second
+		switch {
+		case o.every == 0 && !o.due(6, ref):
+			ref = o.skip(time.Date(y, 1, 1, 0, 0, 0, 0, loc), 1, 0, 0, 0, reverse)
+		case o.every == 0 && !o.due(4, ref):
+			ref = o.skip(time.Date(y, m, 1, 0, 0, 0, 0, loc), 0, 1, 0, 0, reverse)
+		case o.every == 0 && !o.dateDue(ref):
+			ref = o.skip(time.Date(y, m, d, 0, 0, 0, 0, loc), 0, 0, 1, 0, reverse)
+		case o.every == 0 && !o.due(2, ref):
+			ref = o.skip(time.Date(y, m, d, h, 0, 0, 0, loc), 0, 0, 0, time.Hour, reverse)
+		case o.every == 0 && !o.due(1, ref):
+			ref = o.skip(time.Date(y, m, d, h, i, 0, 0, loc), 0, 0, 0, time.Minute, reverse)
+		case o.IsDue(ref):
+			return ref, true
+		default:
+			ref = o.step(ref, reverse)
+		}
+	}
+	return start, false
+}
+
+// skip gives the start of next unit (or last second of prev unit if reverse) from unit start.
+func (o *Oracle) skip(start time.Time, years, months, days int, dur time.Duration, reverse bool) time.Time {
+	if reverse {
+		return start.Add(-time.Second)
+	}
+	return start.AddDate(years, months, days).Add(dur)
+}
+
+func (o *Oracle) step(ref time.Time, reverse bool) time.Time {
+	if reverse {
+		return ref.Add(-time.Second)
+	}
+	return ref.Add(time.Second)
+}
+
+func (o *Oracle) due(pos int, ref time.Time) bool {
+	due, _ := o.match(pos, ref)
+	return due
+}
+
+// dateDue checks the segments that depend only on the date.
+func (o *Oracle) dateDue(ref time.Time) bool {
+	if !o.due(6, ref) || !o.due(4, ref) || !o.due(7, ref) || !o.due(8, ref) {
+		return false
+	}
+
+	day, week := o.segs[3], o.segs[5]
+	if week == "*" || week == "?" {
+		return o.due(3, ref)
+	}
+	// <day> and <weekday> are OR-ed if both are specific
+	if strings.HasPrefix(week, "*/") || strings.HasPrefix(day, "*") || day == "?" {
+		return o.due(3, ref) && o.due(5, ref)
+	}
+	return o.due(3, ref) || o.due(5, ref)
+}
+
+// match checks if any part of segment at pos matches ref.
+// It returns bool or error if any part is invalid.
+func (o *Oracle) match(pos int, ref time.Time) (bool, error) {
+	seg := o.segs[pos]
+	if seg == "*" || seg == "?" {
+		return true, nil
+	}
+
+	due := false
+	for _, part := range strings.Split(seg, ",") {
+		// Only the day and weekday values vary with month
+		key := fmt.Sprint(pos, part)
+		if pos == 3 || pos == 5 {
+			key += ref.Format(" 2006-01")
+		}
+
+		vals, ok := o.cache[key]
+		if !ok {
+			var err error
+			if vals, err = o.values(pos, part, ref); err != nil {
+				return false, err
+			}
+			o.cache[key] = vals
+		}
+		if vals[value(pos, ref)] || vals[-ref.Day()] {
+			due = true
+		}
+	}
+	return due, nil
+}
+
+// value gives the value of ref for segment at pos.
+func value(pos int, ref time.Time) int {
+	switch pos {
+	case 0:
+		return ref.Second()
+	case 1:
+		return ref.Minute()
+	case 2:
+		return ref.Hour()
+	case 3:
+		return ref.Day()
+	case 4:
+		return int(ref.Month())
+	case 5:
+		return int(ref.Weekday())
+	case 6:
+		return ref.Year()
+	case 7:
+		_, week := ref.ISOWeek()
+		return week
+	}
+	return ref.YearDay()
+}
+
+// values enumerates all values that part of segment at pos stands for in the month of ref.
+// The weekday modifiers stand for days of month which are given as negative values.
+func (o *Oracle) values(pos int, part string, ref time.Time) (map[int]bool, error) {
+	bounds, vals := oracleBounds[pos], map[int]bool{}
+	first := time.Date(ref.Year(), ref.Month(), 1, 0, 0, 0, 0, ref.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	weekday := func(day int) int { return int(first.AddDate(0, 0, day-1).Weekday()) }
+	workday := func(day int) bool { return weekday(day) > 0 && weekday(day) < 6 }
+
+	if pos == 3 && strings.ContainsAny(part, "LWB") {
+		switch {
+		case part == "L":
+			vals[last] = true
+		case part == "LBD":
+			for day := last; day > 0; day-- {
+				if workday(day) {
+					vals[day] = true
+					break
+				}
+			}
+		case strings.HasSuffix(part, "BD"):
+			nth, err := number(strings.TrimSuffix(part, "BD"), 1, 23)
+			if err != nil {
+				return nil, err
+			}
+			for day := 1; day <= last; day++ {
+				if workday(day) {
+					if nth--; nth == 0 {
+						vals[day] = true
+						break
+					}
+				}
+			}
+		case strings.HasSuffix(part, "W"):
+			want, err := number(strings.TrimSuffix(part, "W"), 1, 31)
+			if err != nil {
+				return nil, err
+			}
+			// The workday of month closest to wanted day, the earlier one on tie
+			best := 0
+			for day := 1; day <= last; day++ {
+				if workday(day) && (best == 0 || abs(day-want) < abs(best-want)) {
+					best = day
+				}
+			}
+			vals[best] = true
+		default:
+			return nil, errors.New("invalid day: " + part)
+		}
+		return vals, nil
+	}
+
+	if pos == 5 && strings.ContainsAny(part, "L#") {
+		if strings.HasSuffix(part, "L") {
+			want, err := number(strings.TrimSuffix(part, "L"), 0, 7)
+			if err != nil {
+				return nil, err
+			}
+			for day := last; day > last-7; day-- {
+				if weekday(day) == want%7 {
+					vals[-day] = true
+				}
+			}
+			return vals, nil
+		}
+
+		nums := strings.Split(part, "#")
+		if len(nums) != 2 {
+			return nil, errors.New("invalid weekday: " + part)
+		}
+		want, err := number(nums[0], 0, 7)
+		if err != nil {
+			return nil, err
+		}
+		nth, err := number(nums[1], 1, 5)
+		if err != nil {
+			return nil, err
+		}
+		for day, n := 1, 0; day <= last; day++ {
+			if weekday(day) == want%7 {
+				if n++; n == nth {
+					vals[-day] = true
+				}
+			}
+		}
+		return vals, nil
+	}
+
+	// 7 is Sunday only as a value of its own
+	if pos == 5 {
+		bounds[1] = 6
+	}
+
+	step, from, to, stepped := 1, bounds[0], bounds[1], false
+	if nums := strings.Split(part, "/"); len(nums) == 2 {
+		n, err := number(nums[1], 1, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		step, part, stepped = n, nums[0], true
+		if part == "0" {
+			from, part = 0, "*"
+		}
+	} else if len(nums) > 2 {
+		return nil, errors.New("invalid step: " + part)
+	}
+
+	if part != "*" {
+		nums := strings.Split(part, "-")
+		if len(nums) > 2 {
+			return nil, errors.New("invalid range: " + part)
+		}
+		n, err := number(nums[0], bounds[0], oracleBounds[pos][1])
+		if err != nil {
+			return nil, err
+		}
+		from, to = n, n
+		if len(nums) == 2 {
+			if to, err = number(nums[1], from, oracleBounds[pos][1]); err != nil {
+				return nil, err
+			}
+		} else if stepped {
+			// a/n means from a till the end
+			to = bounds[1]
+		}
+	}
+
+	if pos == 5 && part == "7" {
+		from, to = 0, 0
+	}
+	for val := from; val <= to; val += step {
+		vals[val] = true
+	}
+	return vals, nil
+}
+
+// number parses s as int within min and max.
+func number(s string, min, max int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < min || n > max {
+		return 0, errors.New("out of bounds: " + s)
+	}
+	return n, nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
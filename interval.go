@@ -0,0 +1,180 @@
+package gronx
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// IntervalStats is the statistics of gaps between consecutive runs of an expr.
+type IntervalStats struct {
+	// Distribution is the number of gaps by their length.
+	Distribution map[time.Duration]int
+	Min          time.Duration
+	Max          time.Duration
+	Mean         time.Duration
+	Median       time.Duration
+	// Count is the number of gaps.
+	Count int
+}
+
+// Intervals gives the statistics of gaps between consecutive runs of expr
+// within window starting at given time (or now).
+// It returns IntervalStats or error if expr is invalid or runs less than twice.
+func Intervals(expr string, window time.Duration, ref ...time.Time) (IntervalStats, error) {
+	return New().Intervals(expr, window, ref...)
+}
+
+// Intervals gives the statistics of gaps between consecutive runs of expr
+// within window starting at given time (or now), skipping the days excluded by Calendar if any.
+// The gaps are worked out day by day from the compiled times of day, so all runs count however many.
+// It returns IntervalStats or error if expr is invalid or runs less than twice.
+func (g *Gronx) Intervals(expr string, window time.Duration, ref ...time.Time) (IntervalStats, error) {
+	sched, err := g.Compile(expr)
+	if err != nil {
+		return IntervalStats{Distribution: map[time.Duration]int{}}, err
+	}
+
+	ref = append(ref, g.now())
+	gaps := &gapCounter{dist: map[time.Duration]int{}}
+	if sched.every > 0 {
+		gaps.every(sched, ref[0], ref[0].Add(window))
+	} else {
+		gaps.cron(sched, ref[0], ref[0].Add(window))
+	}
+
+	if gaps.count == 0 {
+		return IntervalStats{Distribution: gaps.dist}, errors.New("expr runs less than twice in window: " + expr)
+	}
+	return gaps.stats(), nil
+}
+
+// gapCounter counts the gaps between consecutive runs by their length.
+type gapCounter struct {
+	dist         map[time.Duration]int
+	first, last  time.Time
+	count, ticks int
+}
+
+// tick counts the gap from last run to the run at given time.
+func (c *gapCounter) tick(at time.Time) {
+	if c.ticks > 0 {
+		c.add(at.Sub(c.last), 1)
+	} else {
+		c.first = at
+	}
+	c.last, c.ticks = at, c.ticks+1
+}
+
+func (c *gapCounter) add(gap time.Duration, n int) {
+	c.dist[gap] += n
+	c.count += n
+}
+
+// cron counts the gaps of cron sched from start till until (both inclusive).
+func (c *gapCounter) cron(sched *Schedule, start, until time.Time) {
+	clock, ok := sched.clock()
+	// Gaps between the times of a day that runs all day long
+	daily := map[time.Duration]int{}
+	for i := 1; i < len(clock); i++ {
+		daily[clock[i]-clock[i-1]]++
+	}
+
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, start.Location()); !day.After(until); {
+		next := day.AddDate(0, 0, 1)
+		switch {
+		case !ok || next.Sub(day) != 24*time.Hour:
+			// Times of day don't map to wall clock, eg: on DST switch, so walk the ticks
+			c.walk(sched, day, next, start, until)
+		case len(clock) == 0 || !sched.IsDue(day.Add(clock[0])):
+		case day.Before(start) || next.After(until):
+			for _, at := range clock {
+				if tm := day.Add(at); !tm.Before(start) && !tm.After(until) {
+					c.tick(tm)
+				}
+			}
+		default:
+			c.tick(day.Add(clock[0]))
+			for gap, n := range daily {
+				c.add(gap, n)
+			}
+			c.last, c.ticks = day.Add(clock[len(clock)-1]), c.ticks+len(clock)-1
+		}
+		day = next
+	}
+}
+
+// walk counts the gaps of ticks of sched within the day (till next) that fall in start and until.
+func (c *gapCounter) walk(sched *Schedule, day, next, start, until time.Time) {
+	if day.Before(start) {
+		day = start
+	}
+
+	tick, err := sched.NextTickAfter(day, true)
+	for err == nil && tick.Before(next) && !tick.After(until) && (c.ticks == 0 || tick.After(c.last)) {
+		c.tick(tick)
+		tick, err = sched.NextTickAfter(tick, false)
+	}
+}
+
+// every counts the gaps of @every sched from start till until (both inclusive).
+// Without Calendar it is all the same gap, else it goes day by day to skip the excluded ones.
+func (c *gapCounter) every(sched *Schedule, start, until time.Time) {
+	dur, epoch := sched.every, sched.gron.epoch()
+	// span counts the ticks from..till (exclusive)
+	span := func(from, till time.Time) {
+		first := epoch.Add((from.Sub(epoch) + dur - 1) / dur * dur)
+		if from.Before(epoch) {
+			first = epoch.Add(from.Sub(epoch) / dur * dur)
+		}
+		if !first.Before(till) {
+			return
+		}
+
+		n := int((till.Sub(first)-1)/dur) + 1
+		c.tick(first)
+		c.add(dur, n-1)
+		c.last, c.ticks = first.Add(time.Duration(n-1)*dur), c.ticks+n-1
+	}
+
+	end := until.Add(time.Nanosecond)
+	if sched.gron.Cal == nil {
+		span(start, end)
+		return
+	}
+
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, start.Location()); day.Before(end); {
+		next := day.AddDate(0, 0, 1)
+		if !sched.gron.isExcluded(day) {
+			from, till := day, next
+			if from.Before(start) {
+				from = start
+			}
+			if till.After(end) {
+				till = end
+			}
+			span(from, till)
+		}
+		day = next
+	}
+}
+
+func (c *gapCounter) stats() IntervalStats {
+	lens := make([]time.Duration, 0, len(c.dist))
+	for gap := range c.dist {
+		lens = append(lens, gap)
+	}
+	sort.Slice(lens, func(i, j int) bool { return lens[i] < lens[j] })
+
+	stats := IntervalStats{Distribution: c.dist, Count: c.count, Min: lens[0], Max: lens[len(lens)-1]}
+	stats.Mean = c.last.Sub(c.first) / time.Duration(c.count)
+	for i, seen := 0, 0; i < len(lens); i++ {
+		if seen += c.dist[lens[i]]; seen > c.count/2 {
+			stats.Median = lens[i]
+			break
+		}
+	}
+	return stats
+}
@@ -0,0 +1,92 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervals(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 00:00:00")
+	day := 24 * time.Hour
+
+	t.Run("uniform", func(t *testing.T) {
+		stats, err := Intervals("*/15 * * * *", time.Hour, ref)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if stats.Count != 4 || stats.Min != 15*time.Minute || stats.Max != 15*time.Minute || stats.Mean != 15*time.Minute {
+			t.Errorf("expected 4 gaps of 15m, got %+v", stats)
+		}
+	})
+
+	t.Run("irregular", func(t *testing.T) {
+		stats, err := Intervals("0 9,17 * * MON-FRI", 8*day, ref)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if stats.Min != 8*time.Hour || stats.Max != 64*time.Hour || stats.Median != 8*time.Hour {
+			t.Errorf("expected min 8h, max 64h and median 8h, got %+v", stats)
+		}
+		if stats.Distribution[8*time.Hour] != 6 || stats.Distribution[16*time.Hour] != 4 || stats.Distribution[64*time.Hour] != 1 {
+			t.Errorf("unexpected distribution %v", stats.Distribution)
+		}
+	})
+
+	t.Run("calendar", func(t *testing.T) {
+		gron := New().WithCalendar(DateCalendar{"2021-04-20": true})
+		stats, _ := gron.Intervals("@daily", 3*day, ref)
+		if stats.Min != day || stats.Max != 2*day {
+			t.Errorf("expected min 1d and max 2d, got %+v", stats)
+		}
+	})
+
+	t.Run("every run", func(t *testing.T) {
+		// The 21h gap from 02:59 to 00:00 is beyond first 10000 runs
+		stats, err := Intervals("* 0-2 * * *", 7*day, ref)
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if stats.Count != 7*180 || stats.Min != time.Minute || stats.Max != 21*time.Hour+time.Minute {
+			t.Errorf("expected 1260 gaps, min 1m and max 21h1m, got %+v", stats)
+		}
+		if stats.Distribution[time.Minute] != 7*179 || stats.Distribution[21*time.Hour+time.Minute] != 7 {
+			t.Errorf("unexpected distribution %v", stats.Distribution)
+		}
+
+		stats, _ = Intervals("@everysecond", 30*day, ref.Add(12*time.Hour))
+		if stats.Count != 30*86400 || stats.Max != time.Second || stats.Median != time.Second {
+			t.Errorf("expected 2592000 gaps of 1s, got %d, max %s", stats.Count, stats.Max)
+		}
+	})
+
+	t.Run("every", func(t *testing.T) {
+		stats, _ := Intervals("@every 90m", day, ref)
+		if stats.Count != 16 || stats.Min != 90*time.Minute || stats.Max != 90*time.Minute {
+			t.Errorf("expected 16 gaps of 90m, got %+v", stats)
+		}
+
+		gron := New().WithCalendar(NewWeeklyCalendar(time.Saturday, time.Sunday))
+		stats, _ = gron.Intervals("@every 6h", 7*day, ref)
+		if stats.Count != 20 || stats.Max != 54*time.Hour || stats.Distribution[6*time.Hour] != 19 {
+			t.Errorf("expected 19 gaps of 6h and 54h over weekend, got %+v", stats)
+		}
+	})
+
+	t.Run("dst", func(t *testing.T) {
+		loc, _ := time.LoadLocation("Europe/Berlin")
+		// 2021-03-28 02:00 jumps to 03:00, so 48h from 27th noon is 29th 13:00
+		stats, _ := Intervals("0 */30 * * * *", 2*day, time.Date(2021, time.March, 27, 12, 0, 0, 0, loc))
+		if stats.Min != 30*time.Minute || stats.Max != 30*time.Minute || stats.Count != 2*48 {
+			t.Errorf("expected 96 gaps of 30m, got %+v", stats)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := Intervals("* * *", time.Hour, ref); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+		if _, err := Intervals("@daily", time.Hour, ref); err == nil {
+			t.Error("expected error for expr running once in window")
+		}
+	})
+}
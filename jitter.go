@@ -0,0 +1,65 @@
+package gronx
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Splay gives a delay of whole seconds in [0, max) derived from seed (eg: job ID
+// and host name), so that it is same across runs for the same seed.
+// Without seed the delay is random.
+func Splay(max time.Duration, seed ...string) time.Duration {
+	secs := int64(max / time.Second)
+	if secs <= 0 {
+		return 0
+	}
+	if len(seed) == 0 {
+		return time.Duration(rand.Int63n(secs)) * time.Second
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(strings.Join(seed, "\x00")))
+	return time.Duration(hash.Sum64()%uint64(secs)) * time.Second
+}
+
+// Jitter shifts all ticks of cron expr by a bounded Offset, so that the same
+// schedule on many hosts (or of many jobs) does not fire at the same second.
+// Its IsDue, NextTickAfter and PrevTickBefore all agree on the shifted ticks.
+type Jitter struct {
+	Offset time.Duration
+	gron   *Gronx
+}
+
+// NewJitter gives Jitter with Offset of up to max as per Splay.
+func NewJitter(max time.Duration, seed ...string) *Jitter {
+	return New().Jitter(max, seed...)
+}
+
+// Jitter gives Jitter with Offset of up to max as per Splay, that respects
+// Calendar, CalendarSystem and Clock of Gronx.
+func (g *Gronx) Jitter(max time.Duration, seed ...string) *Jitter {
+	return &Jitter{Offset: Splay(max, seed...), gron: g}
+}
+
+// IsDue checks if cron expr shifted by Offset is due for given reference time (or now as per Clock).
+// It returns bool or error if any.
+func (j *Jitter) IsDue(expr string, ref ...time.Time) (bool, error) {
+	if len(ref) == 0 {
+		ref = append(ref, j.gron.now())
+	}
+	return j.gron.IsDue(expr, ref[0].Add(-j.Offset))
+}
+
+// NextTickAfter gives next run time (shifted by Offset) from the provided time.Time
+func (j *Jitter) NextTickAfter(expr string, start time.Time, inclRefTime bool) (time.Time, error) {
+	next, err := j.gron.NextTickAfter(expr, start.Add(-j.Offset), inclRefTime)
+	return next.Add(j.Offset), err
+}
+
+// PrevTickBefore gives prev run time (shifted by Offset) before the provided time.Time
+func (j *Jitter) PrevTickBefore(expr string, start time.Time, inclRefTime bool) (time.Time, error) {
+	prev, err := j.gron.PrevTickBefore(expr, start.Add(-j.Offset), inclRefTime)
+	return prev.Add(j.Offset), err
+}
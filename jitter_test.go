@@ -0,0 +1,96 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplay(t *testing.T) {
+	t.Run("stable", func(t *testing.T) {
+		delay := Splay(90*time.Second, "job-42", "host1")
+		if delay < 0 || delay >= 90*time.Second || delay%time.Second != 0 {
+			t.Errorf("expected whole seconds in [0, 90s), got %s", delay)
+		}
+		if again := Splay(90*time.Second, "job-42", "host1"); again != delay {
+			t.Errorf("expected %s, got %s", delay, again)
+		}
+	})
+
+	t.Run("spread", func(t *testing.T) {
+		seen := map[time.Duration]bool{}
+		for _, host := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+			seen[Splay(time.Hour, "job", host)] = true
+		}
+		if len(seen) < 4 {
+			t.Errorf("expected offsets to spread, got %v", seen)
+		}
+	})
+
+	t.Run("random", func(t *testing.T) {
+		if delay := Splay(time.Minute); delay < 0 || delay >= time.Minute {
+			t.Errorf("expected [0, 1m), got %s", delay)
+		}
+		if delay := Splay(500*time.Millisecond, "job"); delay != 0 {
+			t.Errorf("expected 0, got %s", delay)
+		}
+	})
+}
+
+func TestJitter(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+	jitter := NewJitter(90*time.Second, "job-42")
+	jitter.Offset = 37 * time.Second
+
+	t.Run("next", func(t *testing.T) {
+		next, err := jitter.NextTickAfter("@hourly", ref, false)
+		if expect := "2021-04-19 13:00:37"; err != nil || next.Format(FullDateFormat) != expect {
+			t.Errorf("expected %s, got %s (err: %v)", expect, next.Format(FullDateFormat), err)
+		}
+		// on the shifted tick
+		next, _ = jitter.NextTickAfter("@hourly", ref.Add(6*time.Minute+37*time.Second), false)
+		if expect := "2021-04-19 14:00:37"; next.Format(FullDateFormat) != expect {
+			t.Errorf("expected %s, got %s", expect, next.Format(FullDateFormat))
+		}
+		next, _ = jitter.NextTickAfter("@hourly", ref.Add(6*time.Minute+37*time.Second), true)
+		if expect := "2021-04-19 13:00:37"; next.Format(FullDateFormat) != expect {
+			t.Errorf("expected %s, got %s", expect, next.Format(FullDateFormat))
+		}
+	})
+
+	t.Run("prev", func(t *testing.T) {
+		prev, err := jitter.PrevTickBefore("@hourly", ref, false)
+		if expect := "2021-04-19 12:00:37"; err != nil || prev.Format(FullDateFormat) != expect {
+			t.Errorf("expected %s, got %s (err: %v)", expect, prev.Format(FullDateFormat), err)
+		}
+	})
+
+	t.Run("is due", func(t *testing.T) {
+		for ref, expect := range map[string]bool{
+			"2021-04-19 13:00:00": false,
+			"2021-04-19 13:00:37": true,
+			"2021-04-19 13:00:38": false,
+		} {
+			due, err := jitter.IsDue("@hourly", parseTime(ref))
+			if err != nil || due != expect {
+				t.Errorf("%s: expected %v, got %v (err: %v)", ref, expect, due, err)
+			}
+		}
+	})
+
+	t.Run("clock", func(t *testing.T) {
+		clock := NewFakeClock(parseTime("2021-04-19 13:00:37"))
+		jitter := New().WithClock(clock).Jitter(0)
+		if due, _ := jitter.IsDue("@hourly"); due {
+			t.Errorf("expected not due without offset")
+		}
+		jitter.Offset = 37 * time.Second
+		if due, _ := jitter.IsDue("@hourly"); !due {
+			t.Errorf("expected due with offset")
+		}
+	})
+}
+
+func parseTime(ref string) time.Time {
+	t, _ := time.Parse(FullDateFormat, ref)
+	return t
+}
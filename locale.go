@@ -0,0 +1,177 @@
+package gronx
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Locale is the table of full month names (January first) and weekday names (Sunday first).
+type Locale struct {
+	Months   [12]string
+	Weekdays [7]string
+}
+
+// Locales are the registered locales by language code.
+var Locales = map[string]Locale{
+	"en": {
+		Months:   [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		Weekdays: [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	},
+	"de": {
+		Months:   [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		Weekdays: [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	},
+	"fr": {
+		Months:   [12]string{"Janvier", "Février", "Mars", "Avril", "Mai", "Juin", "Juillet", "Août", "Septembre", "Octobre", "Novembre", "Décembre"},
+		Weekdays: [7]string{"Dimanche", "Lundi", "Mardi", "Mercredi", "Jeudi", "Vendredi", "Samedi"},
+	},
+	"es": {
+		Months:   [12]string{"Enero", "Febrero", "Marzo", "Abril", "Mayo", "Junio", "Julio", "Agosto", "Septiembre", "Octubre", "Noviembre", "Diciembre"},
+		Weekdays: [7]string{"Domingo", "Lunes", "Martes", "Miércoles", "Jueves", "Viernes", "Sábado"},
+	},
+}
+
+// names maps full month and weekday names (upper cased) of all Locales to their numbers.
+var names, _ = nameMap()
+
+// ascii folds the accented letters, eg: MÄRZ can also be written as MARZ or MAERZ.
+var ascii = []*strings.Replacer{
+	strings.NewReplacer("Á", "A", "À", "A", "Â", "A", "Ä", "A", "É", "E", "È", "E", "Ê", "E", "Í", "I", "Ì", "I", "Î", "I",
+		"Ó", "O", "Ò", "O", "Ô", "O", "Ö", "O", "Ú", "U", "Ù", "U", "Û", "U", "Ü", "U", "Ç", "C", "Ñ", "N"),
+	strings.NewReplacer("Ä", "AE", "Ö", "OE", "Ü", "UE"),
+}
+
+// RegisterLocale adds (or replaces) Locale for given language code so that its names
+// are accepted in expressions and it can be used to Localize.
+// It is not safe for concurrent use, register locales on init.
+// It returns error if any name is empty or conflicts with a name of other locale.
+func RegisterLocale(lang string, loc Locale) error {
+	old, ok := Locales[lang]
+	Locales[lang] = loc
+	if err := checkLocales(); err != nil {
+		if ok {
+			Locales[lang] = old
+		} else {
+			delete(Locales, lang)
+		}
+		return err
+	}
+
+	names, _ = nameMap()
+	bumpRegistry()
+	return nil
+}
+
+// UnregisterLocale removes Locale of given language code so that its names are no longer accepted.
+// It is not safe for concurrent use either.
+// It returns false if there was no such locale.
+func UnregisterLocale(lang string) bool {
+	if _, ok := Locales[lang]; !ok {
+		return false
+	}
+
+	delete(Locales, lang)
+	names, _ = nameMap()
+	bumpRegistry()
+	return true
+}
+
+// nameMap gives all names (and their ASCII variants) of all Locales mapped to their numbers.
+func nameMap() (map[string]string, error) {
+	nums := map[string]string{}
+	add := func(name string, num int) error {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if len(name) < 3 {
+			return errors.New("locale name should have at least 3 letters: " + name)
+		}
+		for _, variant := range []string{name, ascii[0].Replace(name), ascii[1].Replace(name)} {
+			if old, ok := nums[variant]; ok && old != strconv.Itoa(num) {
+				return errors.New("conflicting locale name: " + variant)
+			}
+			nums[variant] = strconv.Itoa(num)
+		}
+		return nil
+	}
+
+	for _, loc := range Locales {
+		for i, name := range loc.Months {
+			if err := add(name, i+1); err != nil {
+				return nil, err
+			}
+		}
+		for i, name := range loc.Weekdays {
+			if err := add(name, i); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return nums, nil
+}
+
+func checkLocales() error {
+	_, err := nameMap()
+	return err
+}
+
+// nameItemRe matches the items of a field, i.e the list items and either side of ranges, steps or nth weekday.
+var nameItemRe = regexp.MustCompile(`[^,\-/#]+`)
+
+// ReplaceFieldNames replaces the items of a single cron field that are exactly a full month
+// or weekday name of any Locale by their numbers, eg: `Januar-März,mai` gives `1-3,5`.
+// The other items (and parts of longer words, eg: `mail`) are left as is.
+func ReplaceFieldNames(field string) string {
+	return nameItemRe.ReplaceAllStringFunc(field, func(item string) string {
+		if num, ok := names[strings.ToUpper(item)]; ok {
+			return num
+		}
+		return item
+	})
+}
+
+var localizeRe = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+// Localize renders the canonical form of cron expr with month and weekday
+// values (and ranges) written as full names of given language.
+// It returns localized expr or error if expr is invalid or language is unknown.
+func Localize(expr, lang string) (string, error) {
+	loc, ok := Locales[lang]
+	if !ok {
+		return "", errors.New("unknown locale: " + lang)
+	}
+
+	segs, err := Segments(expr)
+	if err != nil {
+		return "", err
+	}
+	if !IsValid(expr) {
+		return "", errors.New("invalid cron expr: " + expr)
+	}
+	if segs[0] == everyTag {
+		return strings.Join(segs, " "), nil
+	}
+
+	for pos, table := range map[int][]string{4: loc.Months[:], 5: loc.Weekdays[:]} {
+		parts := strings.Split(segs[pos], ",")
+		for i, part := range parts {
+			if !localizeRe.MatchString(part) {
+				continue
+			}
+
+			vals := strings.Split(part, "-")
+			for j, val := range vals {
+				num, _ := strconv.Atoi(val)
+				if pos == 4 {
+					num--
+				}
+				// 7 is also Sunday
+				vals[j] = table[num%len(table)]
+			}
+			parts[i] = strings.Join(vals, "-")
+		}
+		segs[pos] = strings.Join(parts, ",")
+	}
+
+	return strings.Join(segs, " "), nil
+}
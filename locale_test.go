@@ -0,0 +1,112 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLocale(t *testing.T) {
+	t.Run("full names", func(t *testing.T) {
+		tests := map[string]string{
+			"0 9 * January MONDAY-friday":       "0 0 9 * 1 1-5",
+			"0 9 * * saturday,SAT,Sunday":       "0 0 9 * * 6,6,0",
+			"0 9 1 März,Maerz,Marz,Mai Sonntag": "0 0 9 1 3,3,3,5 0",
+			"0 9 1 Février,Aout mercredi":       "0 0 9 1 2,8 3",
+			"0 9 1 Mayo Miércoles,Sabado":       "0 0 9 1 5 3,6",
+			"0 9 1 MAY,March,MAR *":             "0 0 9 1 5,3,3 *",
+		}
+		for expr, expect := range tests {
+			segs, err := Segments(expr)
+			if err != nil {
+				t.Errorf("%s: expected nil, got %v", expr, err)
+				continue
+			}
+			if actual := strings.Join(segs, " "); actual != expect {
+				t.Errorf("%s: expected %s, got %s", expr, expect, actual)
+			}
+		}
+	})
+
+	t.Run("field names", func(t *testing.T) {
+		tests := map[string]string{
+			"Januar-März,mai": "1-3,5",
+			"Montag#2":        "1#2",
+			"*/Juni":          "*/6",
+			"mail":            "mail",
+			"marche":          "marche",
+			"juni*":           "juni*",
+		}
+		for field, expect := range tests {
+			if actual := ReplaceFieldNames(field); actual != expect {
+				t.Errorf("%s: expected %s, got %s", field, expect, actual)
+			}
+		}
+	})
+
+	t.Run("localize", func(t *testing.T) {
+		tests := []struct{ expr, lang, expect string }{
+			{"0 9 * 1-3,12 MON-FRI", "en", "0 0 9 * January-March,December Monday-Friday"},
+			{"0 9 * * 7,1#2,5L", "de", "0 0 9 * * Sonntag,1#2,5L"},
+			{"0 9 * */2 SAT", "fr", "0 0 9 * */2 Samedi"},
+			{"@every 1h", "es", "@every 1h0m0s"},
+		}
+		for _, test := range tests {
+			actual, err := Localize(test.expr, test.lang)
+			if err != nil || actual != test.expect {
+				t.Errorf("%s: expected %s, got %s (%v)", test.expr, test.expect, actual, err)
+			}
+			if segs, _ := Segments(actual); !IsValid(actual) || len(segs) == 0 {
+				t.Errorf("%s: expected localized expr to be valid", actual)
+			}
+		}
+
+		for _, test := range [][2]string{{"* * * 13 *", "en"}, {"* * *", "en"}, {"* * * * *", "xx"}} {
+			if _, err := Localize(test[0], test[1]); err == nil {
+				t.Errorf("%s: expected error", test[0])
+			}
+		}
+	})
+
+	t.Run("register", func(t *testing.T) {
+		t.Cleanup(func() { UnregisterLocale("it") })
+		it := Locale{
+			Months:   [12]string{"Gennaio", "Febbraio", "Marzo", "Aprile", "Maggio", "Giugno", "Luglio", "Agosto", "Settembre", "Ottobre", "Novembre", "Dicembre"},
+			Weekdays: [7]string{"Domenica", "Lunedì", "Martedì", "Mercoledì", "Giovedì", "Venerdì", "Sabato"},
+		}
+		if err := RegisterLocale("it", it); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if segs, _ := Segments("0 9 * Maggio Lunedi"); strings.Join(segs, " ") != "0 0 9 * 5 1" {
+			t.Errorf("expected 0 0 9 * 5 1, got %v", segs)
+		}
+
+		it.Months[0] = "Marzo"
+		if err := RegisterLocale("it", it); err == nil {
+			t.Error("expected conflict error")
+		}
+		if err := RegisterLocale("xx", Locale{}); err == nil {
+			t.Error("expected error for empty names")
+		}
+		if _, ok := Locales["xx"]; ok {
+			t.Error("expected invalid locale not to be registered")
+		}
+	})
+
+	t.Run("unregister", func(t *testing.T) {
+		if err := RegisterLocale("xx", Locale{
+			Months:   [12]string{"Jan1", "Feb1", "Mar1", "Apr1", "May1", "Jun1", "Jul1", "Aug1", "Sep1", "Oct1", "Nov1", "Dec1"},
+			Weekdays: [7]string{"Sun1", "Mon1", "Tue1", "Wed1", "Thu1", "Fri1", "Sat1"},
+		}); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if !IsValid("0 9 * Jun1 *") {
+			t.Error("expected valid with locale names")
+		}
+		if !UnregisterLocale("xx") || UnregisterLocale("xx") {
+			t.Error("expected locale to be removed once")
+		}
+		if IsValid("0 9 * Jun1 *") {
+			t.Error("expected invalid once locale is removed")
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package gronx
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Modifier is a custom token for a cron segment, eg: `PAYDAY` in <day> segment.
+// The tokens are matched after normalization i.e upper cased with names of months
+// and weekdays replaced by their numbers.
+type Modifier struct {
+	// Pattern matches the whole token (required).
+	Pattern *regexp.Regexp
+	// Validate checks if the token is well formed (optional).
+	Validate func(token string) error
+	// Due checks if the token is due on ref (required).
+	Due func(token string, ref time.Time) (bool, error)
+	// Step gives the earliest time after ref (or latest before ref if reverse) the token
+	// can be due, aligned to start (or end if reverse) of the segment unit (optional).
+	// It lets next/prev tick jump over the values that can never be due.
+	Step func(token string, ref time.Time, reverse bool) time.Time
+}
+
+var modifiers = map[int][]Modifier{}
+
+// RegisterModifier registers custom Modifier for segment at given position
+// viz 0: second, 1: minute, 2: hour, 3: day, 4: month, 5: weekday, 6: year.
+// It is not safe for concurrent use, register modifiers on init.
+// It returns error if position or modifier is invalid.
+func RegisterModifier(pos int, mod Modifier) error {
+	if pos < 0 || pos > 6 {
+		return errors.New("modifier position should be 0-6")
+	}
+	if mod.Pattern == nil || mod.Due == nil {
+		return errors.New("modifier should have Pattern and Due")
+	}
+
+	modifiers[pos] = append(modifiers[pos], mod)
+	bumpRegistry()
+	return nil
+}
+
+// UnregisterModifier removes the custom Modifier(s) with given pattern for segment at given position.
+// It is not safe for concurrent use either.
+// It returns true if any modifier was removed.
+func UnregisterModifier(pos int, pattern string) bool {
+	kept := modifiers[pos][:0]
+	for _, mod := range modifiers[pos] {
+		if mod.Pattern.String() != pattern {
+			kept = append(kept, mod)
+		}
+	}
+
+	removed := len(kept) < len(modifiers[pos])
+	if len(kept) == 0 {
+		delete(modifiers, pos)
+	} else {
+		modifiers[pos] = kept
+	}
+	if removed {
+		bumpRegistry()
+	}
+	return removed
+}
+
+func modifierFor(token string, pos int) (Modifier, bool) {
+	for _, mod := range modifiers[pos] {
+		if mod.Pattern.MatchString(token) {
+			return mod, true
+		}
+	}
+	return Modifier{}, false
+}
+
+func (m Modifier) isDue(token string, ref time.Time) (bool, error) {
+	if m.Validate != nil {
+		if err := m.Validate(token); err != nil {
+			return false, err
+		}
+	}
+	return m.Due(token, ref)
+}
+
+// stepModifiers gives the closest Step of all the offsets in segment.
+// It returns false if any offset is not a custom modifier with Step.
+func stepModifiers(segment string, pos int, ref time.Time, reverse bool) (next time.Time, ok bool) {
+	if len(modifiers[pos]) == 0 {
+		return ref, false
+	}
+
+	for _, offset := range strings.Split(segment, ",") {
+		mod, ok := modifierFor(offset, pos)
+		if !ok || mod.Step == nil {
+			return ref, false
+		}
+
+		step := mod.Step(offset, ref, reverse)
+		if next.IsZero() || (!reverse && step.Before(next)) || (reverse && step.After(next)) {
+			next = step
+		}
+	}
+	return next, true
+}
@@ -0,0 +1,133 @@
+package gronx
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestModifier(t *testing.T) {
+	// PAYDAY is 25th of every month
+	payday := Modifier{
+		Pattern: regexp.MustCompile(`^PAYDAY$`),
+		Due: func(token string, ref time.Time) (bool, error) {
+			return ref.Day() == 25, nil
+		},
+		Step: func(token string, ref time.Time, reverse bool) time.Time {
+			pay := time.Date(ref.Year(), ref.Month(), 25, 0, 0, 0, 0, ref.Location())
+			if reverse {
+				if !pay.Before(ref) {
+					pay = pay.AddDate(0, -1, 0)
+				}
+				return pay.Add(24*time.Hour - time.Second)
+			}
+			if !pay.After(ref) {
+				pay = pay.AddDate(0, 1, 0)
+			}
+			return pay
+		},
+	}
+	// FPn is nth fiscal quarter that starts in April
+	quarter := Modifier{
+		Pattern: regexp.MustCompile(`^FP\d+$`),
+		Validate: func(token string) error {
+			if n, _ := strconv.Atoi(token[2:]); n < 1 || n > 4 {
+				return errors.New("fiscal period should be FP1-FP4")
+			}
+			return nil
+		},
+		Due: func(token string, ref time.Time) (bool, error) {
+			n, _ := strconv.Atoi(token[2:])
+			return (int(ref.Month())+8)%12/3+1 == n, nil
+		},
+	}
+
+	t.Cleanup(func() {
+		UnregisterModifier(3, payday.Pattern.String())
+		UnregisterModifier(4, quarter.Pattern.String())
+	})
+
+	t.Run("register", func(t *testing.T) {
+		if err := RegisterModifier(3, payday); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+		if err := RegisterModifier(4, quarter); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+		if err := RegisterModifier(9, quarter); err == nil {
+			t.Error("expected error for invalid pos")
+		}
+		if err := RegisterModifier(4, Modifier{Pattern: quarter.Pattern}); err == nil {
+			t.Error("expected error for missing Due")
+		}
+	})
+
+	t.Run("is valid", func(t *testing.T) {
+		for _, expr := range []string{"0 9 PAYDAY * *", "0 9 1,PAYDAY FP1 *", "0 9 * fp4 *"} {
+			if !IsValid(expr) {
+				t.Errorf("%s should be valid", expr)
+			}
+		}
+		for _, expr := range []string{"0 9 * FP5 *", "0 9 * * PAYDAY"} {
+			if IsValid(expr) {
+				t.Errorf("%s should not be valid", expr)
+			}
+		}
+	})
+
+	t.Run("is due", func(t *testing.T) {
+		gron := New()
+		ref, _ := time.Parse(FullDateFormat, "2022-05-25 09:00:00")
+		if due, _ := gron.IsDue("0 9 PAYDAY FP1 *", ref); !due {
+			t.Errorf("should be due on %s", ref)
+		}
+		if due, _ := gron.IsDue("0 9 PAYDAY FP2 *", ref); due {
+			t.Errorf("should not be due on %s", ref)
+		}
+	})
+
+	t.Run("next and prev tick", func(t *testing.T) {
+		tests := []struct{ expr, ref, next, prev string }{
+			{"0 9 PAYDAY * *", "2022-05-25 10:00:00", "2022-06-25 09:00:00", "2022-05-25 09:00:00"},
+			{"0 9 PAYDAY FP3 *", "2022-05-01 00:00:00", "2022-10-25 09:00:00", "2021-12-25 09:00:00"},
+			{"0 9 1,PAYDAY * *", "2022-05-02 00:00:00", "2022-05-25 09:00:00", "2022-05-01 09:00:00"},
+		}
+		for _, test := range tests {
+			ref, _ := time.Parse(FullDateFormat, test.ref)
+			next, err := NextTickAfter(test.expr, ref, false)
+			if actual := next.Format(FullDateFormat); err != nil || actual != test.next {
+				t.Errorf("next of %s after %s: expected %s, got %s (%v)", test.expr, test.ref, test.next, actual, err)
+			}
+			prev, err := PrevTickBefore(test.expr, ref, false)
+			if actual := prev.Format(FullDateFormat); err != nil || actual != test.prev {
+				t.Errorf("prev of %s before %s: expected %s, got %s (%v)", test.expr, test.ref, test.prev, actual, err)
+			}
+		}
+	})
+}
+
+func TestUnregisterModifier(t *testing.T) {
+	lucky := Modifier{
+		Pattern: regexp.MustCompile(`^LUCKY$`),
+		Due: func(token string, ref time.Time) (bool, error) {
+			return ref.Day() == 7, nil
+		},
+	}
+	if err := RegisterModifier(3, lucky); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	if !IsValid("0 9 LUCKY * *") {
+		t.Error("expected valid with modifier")
+	}
+	if !UnregisterModifier(3, `^LUCKY$`) {
+		t.Error("expected modifier to be removed")
+	}
+	if UnregisterModifier(3, `^LUCKY$`) {
+		t.Error("expected nothing to be removed")
+	}
+	if IsValid("0 9 LUCKY * *") {
+		t.Error("expected invalid without modifier")
+	}
+}
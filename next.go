@@ -15,37 +15,71 @@ const CronDateFormat = "2006-01-02 15:04"
 // FullDateFormat is Y-m-d H:i:s (with seconds)
 const FullDateFormat = "2006-01-02 15:04:05"
 
+// MilliDateFormat is Y-m-d H:i:s.v (with milliseconds) for sub-second @every intervals
+const MilliDateFormat = "2006-01-02 15:04:05.000"
+
 // NextTick gives next run time from now
 func NextTick(expr string, inclRefTime bool) (time.Time, error) {
-	return NextTickAfter(expr, time.Now(), inclRefTime)
+	return New().NextTick(expr, inclRefTime)
+}
+
+// NextTick gives next run time from now (as per Clock)
+func (g *Gronx) NextTick(expr string, inclRefTime bool) (time.Time, error) {
+	return g.NextTickAfter(expr, g.now(), inclRefTime)
 }
 
 // NextTickAfter gives next run time from the provided time.Time
 func NextTickAfter(expr string, start time.Time, inclRefTime bool) (time.Time, error) {
-	gron, next := New(), start.Truncate(time.Second)
-	due, err := gron.IsDue(expr, start)
-	if err != nil || (due && inclRefTime) {
+	return New().NextTickAfter(expr, start, inclRefTime)
+}
+
+// NextTickAfter gives next run time from the provided time.Time
+// skipping the days excluded by Calendar if any.
+func (g *Gronx) NextTickAfter(expr string, start time.Time, inclRefTime bool) (time.Time, error) {
+	segments, err := g.segments(expr)
+	if err != nil {
 		return start, err
 	}
+	return g.tickAfter(segments, start, inclRefTime)
+}
 
-	segments, _ := Segments(expr)
-	if len(segments) > 6 && isUnreachableYear(segments[6], next, false) {
+// tickAfter gives next run time of cron segments from the provided time.Time
+func (g *Gronx) tickAfter(segments []string, start time.Time, inclRefTime bool) (time.Time, error) {
+	next := start.Truncate(time.Second)
+	if segments[0] == everyTag {
+		dur, err := parseEvery(segments[1])
+		if err != nil {
+			return next, err
+		}
+		return g.everyLoop(dur, start, inclRefTime, false)
+	}
+
+	due, err := g.segmentsDue(segments, start)
+	if err != nil || (due && inclRefTime) {
+		return start, err
+	}
+	if len(segments) > 6 && isUnreachableYear(segments[6], g.system().Value(next, 6), false) {
 		return next, fmt.Errorf("unreachable year segment: %s", segments[6])
 	}
 
-	next, err = loop(gron, segments, next, inclRefTime, false)
-	// Ignore superfluous err
-	if err != nil && gron.isDue(expr, next) {
-		err = nil
+	next, err = loop(g, segments, next, inclRefTime, false)
+	// Ignore superfluous err, unless search could not move off the ref time
+	if err != nil {
+		if due, _ := g.segmentsDue(segments, next); due && !next.Equal(start.Truncate(time.Second)) {
+			err = nil
+		}
 	}
 	return next, err
 }
 
 func loop(gron *Gronx, segments []string, start time.Time, incl bool, reverse bool) (next time.Time, err error) {
-	iter, next, bumped := 500, start, false
+	iter, next, bumped := gron.search.iterations(), start, false
 over:
 	for iter > 0 {
 		iter--
+		if err = gron.search.check(next); err != nil {
+			return start, err
+		}
 		skipMonthDayForIter := false
 		for i := 0; i < len(segments); i++ {
 			pos := len(segments) - 1 - i
@@ -60,7 +94,8 @@ over:
 				if isMonthDay && skipMonthDayForIter {
 					continue
 				}
-				if next, bumped, err = bumpUntilDue(gron.C, seg, pos, next, reverse); bumped {
+				// Exhausted bump has moved next all the same, so go over too
+				if next, bumped, err = bumpUntilDue(gron.C, seg, pos, next, reverse); bumped || err != nil {
 					goto over
 				}
 				continue
@@ -116,21 +151,51 @@ over:
 			next = next.Add(delta)
 			continue
 		}
+		if gron.isExcluded(next) {
+			next = bumpDay(next, reverse)
+			continue
+		}
+		if err = gron.search.check(next); err != nil {
+			return start, err
+		}
 		return
 	}
 	return start, errors.New("tried so hard")
 }
 
+// everyLoop gives next (or prev if reverse) tick of interval that is not excluded by Calendar.
+func (g *Gronx) everyLoop(dur time.Duration, start time.Time, incl, reverse bool) (time.Time, error) {
+	next := g.everyTick(dur, start, incl, reverse)
+	for iter := g.search.iterations(); iter > 0; iter-- {
+		if err := g.search.check(next); err != nil {
+			return start, err
+		}
+		if !g.isExcluded(next) {
+			return next, nil
+		}
+		next = g.everyTick(dur, bumpDay(next, reverse), true, reverse)
+	}
+	return start, errors.New("tried so hard")
+}
+
+// bumpDay gives start of next day (or end of prev day if reverse).
+func bumpDay(ref time.Time, reverse bool) time.Time {
+	if reverse {
+		return bumpReverse(ref, 3)
+	}
+	return bump(ref, 3)
+}
+
 var dashRe = regexp.MustCompile(`/.*$`)
 
-func isUnreachableYear(year string, ref time.Time, reverse bool) bool {
+func isUnreachableYear(year string, edge int, reverse bool) bool {
 	if year == "*" || year == "?" {
 		return false
 	}
 
-	edge := ref.Year()
 	for _, offset := range strings.Split(year, ",") {
-		if strings.Index(offset, "*/") == 0 || strings.Index(offset, "0/") == 0 {
+		// Open ended steps (eg: */2, 2020/5) go on forever
+		if strings.Index(offset, "*/") == 0 || strings.Contains(offset, "/") && !strings.Contains(offset, "-") {
 			return false
 		}
 		for _, part := range strings.Split(dashRe.ReplaceAllString(offset, ""), "-") {
@@ -143,20 +208,20 @@ func isUnreachableYear(year string, ref time.Time, reverse bool) bool {
 	return true
 }
 
-var limit = map[int]int{0: 60, 1: 60, 2: 24, 3: 31, 4: 12, 5: 366, 6: 100}
+var limit = map[int]int{0: 60, 1: 60, 2: 24, 3: 31, 4: 12, 5: 366, 6: 100, 7: 2300, 8: 1470}
 
 func bumpUntilDue(c Checker, segment string, pos int, ref time.Time, reverse bool) (time.Time, bool, error) {
 	// <second> <minute> <hour> <day> <month> <weekday> <year>
-	iter := limit[pos]
+	iter, sys := limit[pos], systemOf(c)
 	for iter > 0 {
 		c.SetRef(ref)
 		if ok, _ := c.CheckDue(segment, pos); ok {
 			return ref, iter != limit[pos], nil
 		}
-		if reverse {
-			ref = bumpReverse(ref, pos)
+		if step, ok := stepModifiers(segment, pos, ref, reverse); ok && (reverse && step.Before(ref) || !reverse && step.After(ref)) {
+			ref = step
 		} else {
-			ref = bump(ref, pos)
+			ref = sys.Bump(ref, pos, reverse)
 		}
 		iter--
 	}
@@ -169,13 +234,21 @@ func bump(ref time.Time, pos int) time.Time {
 	switch pos {
 	case 0:
 		ref = ref.Add(time.Second)
+	// On DST fall back the repeated wall clock maps to its first pass, so fall back to absolute time (offsets are whole minutes)
 	case 1:
-		minTime := ref.Add(time.Minute)
-		ref = time.Date(minTime.Year(), minTime.Month(), minTime.Day(), minTime.Hour(), minTime.Minute(), 0, 0, loc)
+		next := time.Date(ref.Year(), ref.Month(), ref.Day(), ref.Hour(), ref.Minute()+1, 0, 0, loc)
+		if !next.After(ref) {
+			next = ref.Add(time.Minute).Truncate(time.Minute)
+		}
+		ref = next
 	case 2:
-		hTime := ref.Add(time.Hour)
-		ref = time.Date(hTime.Year(), hTime.Month(), hTime.Day(), hTime.Hour(), 0, 0, 0, loc)
-	case 3, 5:
+		// Step the wall clock as offsets can be in half or quarter hours, eg: Australia/Lord_Howe
+		next := ref
+		for h := 1; !next.After(ref); h++ {
+			next = time.Date(ref.Year(), ref.Month(), ref.Day(), ref.Hour()+h, 0, 0, 0, loc)
+		}
+		ref = next
+	case 3, 5, 7, 8:
 		dTime := ref.AddDate(0, 0, 1)
 		ref = time.Date(dTime.Year(), dTime.Month(), dTime.Day(), 0, 0, 0, 0, loc)
 	case 4:
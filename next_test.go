@@ -167,3 +167,81 @@ func TestIsUnreachableYearNextTickAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestNextTickAfterLastTick(t *testing.T) {
+	// Due on ref itself but no tick after that
+	ref := time.Date(2022, time.December, 25, 2, 0, 0, 0, time.UTC)
+	if next, err := NextTickAfter("0 2 * * SUN 2022", ref, false); err == nil {
+		t.Errorf("expected error, got next tick %v", next)
+	}
+	if prev, err := PrevTickBefore("0 2 * * SUN 2022", time.Date(2022, time.January, 2, 2, 0, 0, 0, time.UTC), false); err == nil {
+		t.Errorf("expected error, got prev tick %v", prev)
+	}
+}
+
+func TestNextTickAfterDSTFallBack(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no tz data")
+	}
+
+	// 2021-11-07 02:00 EDT falls back to 01:00 EST
+	ref := time.Date(2021, time.November, 7, 1, 56, 0, 0, loc)
+	for _, expr := range []string{"*/7 * * * *", "@hourly"} {
+		next, err := NextTickAfter(expr, ref, false)
+		if err != nil || next.Format("15:04 MST") != "02:00 EST" {
+			t.Errorf("%s: expected 02:00 EST after %s, got %s (%v)", expr, ref, next, err)
+		}
+	}
+
+	// Second pass of 01:56
+	ref = ref.Add(time.Hour)
+	if next, err := NextTickAfter("* * * * *", ref, false); err != nil || next.Sub(ref) != time.Minute {
+		t.Errorf("expected 01:57 EST after %s, got %s (%v)", ref, next, err)
+	}
+	if next, err := NextTickAfter("30 2 * * *", time.Date(2021, time.November, 6, 3, 0, 0, 0, loc), false); err != nil || next.Format("2006-01-02 15:04 MST") != "2021-11-07 02:30 EST" {
+		t.Errorf("expected 2021-11-07 02:30 EST, got %s (%v)", next, err)
+	}
+}
+
+func TestNextTickAfterHalfHourDST(t *testing.T) {
+	loc, err := time.LoadLocation("Australia/Lord_Howe")
+	if err != nil {
+		t.Skip("no tz data")
+	}
+
+	at := func(hour, min int) time.Time {
+		return time.Date(2021, time.April, 3, hour, min, 0, 0, time.UTC).In(loc)
+	}
+	tests := []struct {
+		ref    time.Time
+		expr   string
+		expect string
+	}{
+		// 2021-04-04 02:00 +1100 (15:00 UTC) falls back to 01:30 +1030
+		{at(14, 10), "@hourly", "2021-04-04 02:00 +1030"},
+		{at(15, 10), "@hourly", "2021-04-04 02:00 +1030"},
+		{at(15, 10), "15 * * * *", "2021-04-04 02:15 +1030"},
+		{at(15, 20), "* * * * *", "2021-04-04 01:51 +1030"},
+		// 2021-10-03 02:00 +1030 springs forward to 02:30 +1100
+		{time.Date(2021, time.October, 3, 1, 10, 0, 0, loc), "0 * * * *", "2021-10-03 03:00 +1100"},
+		{time.Date(2021, time.October, 3, 1, 10, 0, 0, loc), "45 * * * *", "2021-10-03 01:45 +1030"},
+	}
+	for _, test := range tests {
+		next, err := NextTickAfter(test.expr, test.ref, false)
+		if actual := next.Format("2006-01-02 15:04 -0700"); err != nil || actual != test.expect {
+			t.Errorf("%s: expected %s after %s, got %s (%v)", test.expr, test.expect, test.ref, actual, err)
+		}
+	}
+
+	if loc, err = time.LoadLocation("America/St_Johns"); err != nil {
+		t.Skip("no tz data")
+	}
+	// 2021-03-14 02:00 -0330 springs forward to 03:00 -0230
+	ref := time.Date(2021, time.March, 14, 1, 10, 0, 0, loc)
+	for _, expr := range []string{"@hourly", "0 3 * * *"} {
+		if next, err := NextTickAfter(expr, ref, false); err != nil || next.Format("2006-01-02 15:04 -0700") != "2021-03-14 03:00 -0230" {
+			t.Errorf("%s: expected 2021-03-14 03:00 -0230 after %s, got %s (%v)", expr, ref, next, err)
+		}
+	}
+}
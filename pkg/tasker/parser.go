@@ -43,8 +43,8 @@ func MustParseTaskfile(opts Option) []Task {
 }
 
 // var cronRe = regexp.MustCompile(`^((?:[^\s]+\s+){5,6}(?:\d{4})?)(?:\s+)?(.*)`)
-var aliasRe = regexp.MustCompile(`^(@(?:annually|yearly|monthly|weekly|daily|hourly|5minutes|10minutes|15minutes|30minutes|always|everysecond))(?:\s+)?(.*)`)
-var segRe = regexp.MustCompile(`(?i),|/\d+$|^\d+-\d+$|^([0-7]|sun|mon|tue|wed|thu|fri|sat)(L|W|#\d)?$|-([0-7]|sun|mon|tue|wed|thu|fri|sat)$|\d{4}`)
+var aliasRe = regexp.MustCompile(`^(@(?:annually|yearly|monthly|weekly|daily|hourly|5minutes|10minutes|15minutes|30minutes|always|everysecond|every\s+[\w.]+))(?:\s+)?(.*)`)
+var segRe = regexp.MustCompile(`(?i),|/\d+$|^\d+-\d+$|^([0-7]|sun|mon|tue|wed|thu|fri|sat)(L|W|#\d)?$|-([0-7]|sun|mon|tue|wed|thu|fri|sat)$|\d{4}|^[wd](\*|\d+)(-[wd]?\d+)?(/\d+)?$`)
 
 func linesToTasks(lines []string) []Task {
 	var tasks []Task
@@ -74,11 +74,11 @@ func parseLine(line string) (match []string) {
 	i, nseg, llen := 0, 0, len(line)-1
 	match = append(match, line)
 
-	for ; i < llen && nseg <= 7; i++ {
+	for ; i < llen && nseg <= 9; i++ {
 		isWs := strings.ContainsAny(line[i:i+1], "\t ")
 		if nseg >= 5 {
 			seg, ws := "", line[i-1:i]
-			for i < llen && !strings.ContainsAny(line[i:i+1], "\t ") {
+			for i <= llen && !strings.ContainsAny(line[i:i+1], "\t ") {
 				i, seg = i+1, seg+line[i:i+1]
 			}
 			if isCronPart(seg) {
@@ -103,5 +103,5 @@ func parseLine(line string) (match []string) {
 }
 
 func isCronPart(seg string) bool {
-	return seg != "" && seg[0] != '/' && (seg[0] == '*' || seg[0] == '?' || segRe.MatchString(seg))
+	return seg != "" && seg[0] != '/' && (seg[0] == '*' || seg[0] == '?' || segRe.MatchString(gronx.ReplaceFieldNames(seg)))
 }
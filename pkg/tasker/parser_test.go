@@ -41,3 +41,61 @@ func TestMustParseTaskfile(t *testing.T) {
 		})
 	})
 }
+
+func TestParseEvery(t *testing.T) {
+	t.Run("@every interval", func(t *testing.T) {
+		tasks := linesToTasks([]string{"@every 1h30m echo every", "@every  90s\techo tabbed", "@every 1x echo invalid"})
+		if len(tasks) != 2 {
+			t.Fatalf("should have 2 tasks, got %d", len(tasks))
+		}
+		if tasks[0].Expr != "@every 1h30m" || tasks[0].Cmd != "echo every" {
+			t.Errorf("expected '@every 1h30m' and 'echo every', got '%s' and '%s'", tasks[0].Expr, tasks[0].Cmd)
+		}
+		if tasks[1].Expr != "@every  90s" || tasks[1].Cmd != "echo tabbed" {
+			t.Errorf("expected '@every  90s' and 'echo tabbed', got '%s' and '%s'", tasks[1].Expr, tasks[1].Cmd)
+		}
+	})
+}
+
+func TestParseExtensions(t *testing.T) {
+	t.Run("iso week and day of year", func(t *testing.T) {
+		tasks := linesToTasks([]string{"0 9 * * 1 W*/2 echo odd", "0 9 * * * 2030 D100 W1-W26 echo doy", "0 9 * * * w3m example.com"})
+		if len(tasks) != 3 {
+			t.Fatalf("should have 3 tasks, got %d", len(tasks))
+		}
+		expects := []Task{{"0 9 * * 1 W*/2", "echo odd"}, {"0 9 * * * 2030 D100 W1-W26", "echo doy"}, {"0 9 * * *", "w3m example.com"}}
+		for i, expect := range expects {
+			if expr := strings.Join(strings.Fields(tasks[i].Expr), " "); expr != expect.Expr || tasks[i].Cmd != expect.Cmd {
+				t.Errorf("expected %v, got %v", expect, tasks[i])
+			}
+		}
+	})
+}
+
+func TestParseLocaleNames(t *testing.T) {
+	t.Run("full and localized names", func(t *testing.T) {
+		tasks := linesToTasks([]string{"0 9 * Januar Montag echo de", "0 0 9 * * Lundi-Vendredi echo fr", "0 0 9 1 * Sábado,Domingo echo es"})
+		if len(tasks) != 3 {
+			t.Fatalf("should have 3 tasks, got %d", len(tasks))
+		}
+		expects := []Task{{"0 9 * Januar Montag", "echo de"}, {"0 0 9 * * Lundi-Vendredi", "echo fr"}, {"0 0 9 1 * Sábado,Domingo", "echo es"}}
+		for i, expect := range expects {
+			if expr := strings.Join(strings.Fields(tasks[i].Expr), " "); expr != expect.Expr || tasks[i].Cmd != expect.Cmd {
+				t.Errorf("expected %v, got %v", expect, tasks[i])
+			}
+		}
+	})
+
+	t.Run("commands like names", func(t *testing.T) {
+		tasks := linesToTasks([]string{"0 9 * * * mail -s hi root", "0 9 * * * marche", "0 9 * * * juni* --all"})
+		if len(tasks) != 3 {
+			t.Fatalf("should have 3 tasks, got %d", len(tasks))
+		}
+		expects := []Task{{"0 9 * * *", "mail -s hi root"}, {"0 9 * * *", "marche"}, {"0 9 * * *", "juni* --all"}}
+		for i, expect := range expects {
+			if expr := strings.Join(strings.Fields(tasks[i].Expr), " "); expr != expect.Expr || tasks[i].Cmd != expect.Cmd {
+				t.Errorf("expected %v, got %v", expect, tasks[i])
+			}
+		}
+	})
+}
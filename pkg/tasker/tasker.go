@@ -20,12 +20,14 @@ import (
 
 // Option is the config options for Tasker.
 type Option struct {
-	File    string
-	Tz      string
-	Shell   string
-	Out     string
-	Until   int64
-	Verbose bool
+	File     string
+	Tz       string
+	Shell    string
+	Out      string
+	Holidays string
+	Until    int64
+	Splay    time.Duration
+	Verbose  bool
 }
 
 // TaskFunc is the actual task handler.
@@ -43,9 +45,16 @@ type Tasker struct {
 	ctx       context.Context
 	loc       *time.Location
 	gron      *gronx.Gronx
+	plan      *gronx.Planner
+	clock     gronx.Clock
+	splay     time.Duration
+	tick      time.Duration
+	seed      []string
 	Log       *log.Logger
 	exprs     map[string][]string
 	tasks     map[string]TaskFunc
+	epochs    map[string]time.Time
+	planned   map[string][]string
 	mutex     map[string]*uint32
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
@@ -96,17 +105,31 @@ func New(opt Option) *Tasker {
 		logger = log.New(file, "", log.LstdFlags)
 	}
 
+	if opt.Holidays != "" {
+		cal, err := gronx.LoadHolidays(opt.Holidays)
+		if err != nil {
+			log.Printf("can't load holidays file: %s: %v", opt.Holidays, err)
+			exit(1)
+		}
+
+		gron.WithCalendar(cal)
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
-	return &Tasker{
+	taskr := &Tasker{
 		Log:       logger,
 		loc:       loc,
 		gron:      gron,
+		clock:     gronx.SystemClock{},
+		tick:      time.Minute,
 		exprs:     exprs,
 		tasks:     tasks,
 		verbose:   opt.Verbose,
 		ctx:       ctx,
 		ctxCancel: cancel,
 	}
+
+	return taskr.WithSplay(opt.Splay)
 }
 
 // WithContext adds a parent context to the Tasker struct
@@ -116,6 +139,34 @@ func (t *Tasker) WithContext(ctx context.Context) *Tasker {
 	return t
 }
 
+// WithCalendar skips running tasks on the days excluded by given calendar.
+// It returns itself for fluency.
+func (t *Tasker) WithCalendar(cal gronx.Calendar) *Tasker {
+	t.gron.WithCalendar(cal)
+	return t
+}
+
+// WithClock sets the Clock that tells time and waits for ticks, eg: gronx.FakeClock in tests.
+// It returns itself for fluency.
+func (t *Tasker) WithClock(clock gronx.Clock) *Tasker {
+	t.clock = clock
+	t.gron.WithClock(clock)
+	return t
+}
+
+// WithSplay delays each due task by up to max, so that the same tasks on many hosts
+// don't run at the same second. The delay is stable per task and seed (host name by default).
+// It returns itself for fluency.
+func (t *Tasker) WithSplay(max time.Duration, seed ...string) *Tasker {
+	if len(seed) == 0 {
+		host, _ := os.Hostname()
+		seed = append(seed, host)
+	}
+
+	t.splay, t.seed = max, seed
+	return t
+}
+
 // Shell gives a pair of shell and arg.
 // It returns array of string.
 func Shell(shell ...string) []string {
@@ -174,6 +225,22 @@ func (t *Tasker) Task(expr string, task TaskFunc, concurrent ...bool) *Tasker {
 	return t
 }
 
+// TaskFrom appends new task handler for given cron expr, anchoring its @every interval at epoch
+// instead of gronx.Epoch, so that tasks with same interval can run at different offsets.
+// It returns Tasker (itself) for fluency and bails if expr is invalid.
+func (t *Tasker) TaskFrom(expr string, epoch time.Time, task TaskFunc, concurrent ...bool) *Tasker {
+	t.Task(expr, task, concurrent...)
+	if len(t.epochs) == 0 {
+		t.epochs = make(map[string]time.Time)
+	}
+
+	segs, _ := gronx.Segments(expr)
+	refs := t.exprs[strings.Join(segs, " ")]
+	t.epochs[refs[len(refs)-1]] = epoch
+
+	return t
+}
+
 // Until sets the cutoff time until which the tasker runs.
 // It returns itself for fluency.
 func (t *Tasker) Until(until interface{}) *Tasker {
@@ -191,29 +258,28 @@ func (t *Tasker) Until(until interface{}) *Tasker {
 }
 
 func (t *Tasker) now() time.Time {
-	return time.Now().In(t.loc)
+	return t.clock.Now().In(t.loc)
 }
 
-// Run runs the task manager.
+// Run runs the task manager, sleeping until the next due time of tasks.
 func (t *Tasker) Run() {
 	t.doSetup()
 	t.running = true
 
-	first := true
 	for !t.abort && !t.timeout {
-		ref, willTime := t.tickTimer(first)
+		ref, willTime := t.tickTimer()
 		if t.timeout || t.abort {
 			break
 		}
 
-		tasks := make(map[string]TaskFunc)
-		t.gron.C.SetRef(ref)
-		for expr, refs := range t.exprs {
-			if due, _ := t.gron.SegmentsDue(strings.Split(expr, " ")); !due {
-				continue
-			}
+		due, removed := t.plan.Advance(ref)
+		for _, id := range removed {
+			t.Log.Printf("[tasker] tasks %v will not run again", t.planned[id])
+		}
 
-			for _, ref := range refs {
+		tasks := make(map[string]TaskFunc)
+		for _, id := range due {
+			for _, ref := range t.planned[id] {
 				tasks[ref] = t.tasks[ref]
 			}
 		}
@@ -222,7 +288,6 @@ func (t *Tasker) Run() {
 			t.runTasks(tasks)
 		}
 
-		first = false
 		t.timeout = willTime
 	}
 
@@ -258,14 +323,19 @@ func (t *Tasker) doSetup() {
 		t.Log.Printf("[tasker] final tick on or before %s", t.until.Format(dateFormat))
 	}
 
-	// If we have seconds precision tickSec should be 1
+	// If we have seconds precision tick should be 1s, or even finer for sub-second intervals
+	t.tick = time.Minute
 	for expr := range t.exprs {
-		if expr[0:2] != "0 " {
-			tickSec = 1
-			break
+		if expr[0:2] != "0 " && t.tick > time.Second {
+			t.tick = time.Second
+		}
+		if dur := subSecond(expr); dur > 0 {
+			t.tick = gcd(t.tick, dur)
 		}
 	}
 
+	t.doPlan()
+
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
 
@@ -282,37 +352,98 @@ func (t *Tasker) doSetup() {
 	}()
 }
 
-var tickSec = 60
+// doPlan plans the tasks of same expr under one id (the expr), anchored tasks under their own ref.
+func (t *Tasker) doPlan() {
+	t.plan, t.planned = t.gron.Planner(), make(map[string][]string)
+	now := t.now()
+	for expr, refs := range t.exprs {
+		for _, ref := range refs {
+			id, gron := expr, t.gron
+			if epoch, ok := t.epochs[ref]; ok {
+				id, gron = ref, &gronx.Gronx{}
+				*gron = *t.gron
+				gron.WithEpoch(epoch)
+			}
+			if _, ok := t.planned[id]; !ok {
+				sched, err := gron.Compile(expr)
+				if err == nil {
+					err = t.plan.AddSchedule(id, sched, now)
+				}
+				if err != nil {
+					t.Log.Printf("[tasker] task %s will not run: %v", ref, err)
+					continue
+				}
+			}
+			t.planned[id] = append(t.planned[id], ref)
+		}
+	}
+}
+
+// subSecond gives the duration of sub-second interval expr (eg: @every 250ms), 0 otherwise.
+func subSecond(expr string) time.Duration {
+	segs := strings.Split(expr, " ")
+	if len(segs) != 2 || segs[0] != "@every" {
+		return 0
+	}
+	if dur, err := time.ParseDuration(segs[1]); err == nil && gronx.Precision(dur) < time.Second {
+		return dur
+	}
+	return 0
+}
+
+func gcd(a, b time.Duration) time.Duration {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
 
-func (t *Tasker) tickTimer(first bool) (time.Time, bool) {
+func (t *Tasker) tickTimer() (time.Time, bool) {
 	now, timed, willTime := t.now(), !t.until.IsZero(), false
 	if t.timeout || t.abort {
 		return now, willTime
 	}
 
-	wait := tickSec - now.Second()%tickSec
-	if !first && wait == 0 {
-		wait = tickSec
+	next, _, ok := t.plan.Peek()
+	if !ok {
+		// Nothing will ever be due, so just wait for until or abort
+		next = t.until
+		if !timed {
+			next = now.Add(100 * 365 * 24 * time.Hour)
+		}
 	}
 
-	if wait < 1 || wait > tickSec {
-		return now, willTime
+	// Wake up past the due time by the fraction of second we started at unless tick is finer than a second
+	wake := next
+	if t.tick >= time.Second {
+		wake = wake.Add(now.Sub(now.Truncate(time.Second)))
 	}
 
-	next := now.Add(time.Duration(wait) * time.Second)
-	willTime = timed && next.After(t.until)
+	willTime = timed && wake.After(t.until)
 	if t.verbose && !willTime {
-		t.Log.Printf("[tasker] next tick on %s", next.Format(dateFormat))
+		t.Log.Printf("[tasker] next tick on %s", wake.Format(dateFormat))
 	}
 
 	if willTime {
-		next = now.Add(time.Duration(tickSec) - now.Sub(t.until))
+		// Wake up just past until
+		wake = t.until.Add(time.Nanosecond)
 	}
-	for !t.abort && !t.timeout && t.now().Before(next) {
-		time.Sleep(100 * time.Millisecond)
+	if wait := wake.Sub(t.now()); !t.abort && !t.timeout && wait > 0 {
+		timer := t.clock.NewTimer(wait)
+		select {
+		case <-timer.C():
+		case <-t.ctx.Done():
+			timer.Stop()
+			t.abort = true
+		}
 	}
 
-	t.timeout = timed && next.After(t.until)
+	t.timeout = timed && wake.After(t.until)
+
+	// Catch up once with the ticks missed while asleep (eg: system suspend)
+	if now := t.now().Truncate(t.tick); now.After(next) {
+		next = now
+	}
 
 	return next, willTime
 }
@@ -355,6 +486,25 @@ func (t *Tasker) doRun(ctx context.Context, ref string, task TaskFunc, rc chan r
 		return
 	}
 
+	seed := append(t.seed[:len(t.seed):len(t.seed)], ref)
+	if delay := gronx.Splay(t.splay, seed...); delay > 0 {
+		if t.verbose {
+			t.Log.Printf("[tasker] task %s splayed by %s\n", ref, delay)
+		}
+
+		timer := t.clock.NewTimer(delay)
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+			if lock, ok := t.mutex[ref]; ok {
+				atomic.StoreUint32(lock, 0)
+			}
+			rc <- result{ctx.Err(), ref, 0}
+			return
+		}
+	}
+
 	if t.verbose {
 		t.Log.Printf("[tasker] task %s running\n", ref)
 	}
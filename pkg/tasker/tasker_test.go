@@ -6,8 +6,12 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/adhocore/gronx"
 )
 
 func TestNew(t *testing.T) {
@@ -18,6 +22,21 @@ func TestNew(t *testing.T) {
 	t.Run("New invalid Out", func(t *testing.T) {
 		New(Option{Out: "/a/b/c/d/e/f/out.log"})
 	})
+	t.Run("New invalid Holidays", func(t *testing.T) {
+		New(Option{Holidays: "../../test/holidays.txtx"})
+	})
+	t.Run("New with Holidays", func(t *testing.T) {
+		taskr := New(Option{Holidays: "../../test/holidays.txt"})
+		ref, _ := time.Parse("2006-01-02 15:04:05", "2022-12-25 00:00:00")
+		if due, _ := taskr.gron.IsDue("* * * * *", ref); due {
+			t.Error("task should not be due on holiday")
+		}
+
+		taskr.WithCalendar(nil)
+		if due, _ := taskr.gron.IsDue("* * * * *", ref); !due {
+			t.Error("task should be due without calendar")
+		}
+	})
 	t.Run("Invalid Until", func(t *testing.T) {
 		var zero time.Time
 
@@ -34,7 +53,6 @@ func TestNew(t *testing.T) {
 
 func TestRun(t *testing.T) {
 	t.Run("Run", func(t *testing.T) {
-		tickSec = 1
 		taskr := New(Option{Verbose: true, Out: "../../test/tasker.out"})
 
 		called := 0
@@ -62,14 +80,14 @@ func TestRun(t *testing.T) {
 			t.Errorf("task should run 2 times, ran %d times", called)
 		}
 
-		wait := tickSec - now.Second()%tickSec
-		tickDur := time.Duration(wait) * time.Second
+		tick := taskr.tick
+		next := now.Truncate(tick).Add(tick)
 		start := now.Format(dateFormat)
 		end := now.Add(dur).Format(dateFormat)
-		next1 := now.Add(tickDur).Format(dateFormat)
-		fin1 := now.Add(tickDur + 2*time.Second).Format(dateFormat)
-		next2 := now.Add(tickDur + time.Duration(tickSec)*time.Second).Format(dateFormat)
-		fin2 := now.Add(tickDur + time.Duration(tickSec)*time.Second).Format(dateFormat)
+		next1 := next.Format(dateFormat)
+		fin1 := next.Add(2 * time.Second).Format(dateFormat)
+		next2 := next.Add(tick).Format(dateFormat)
+		fin2 := next.Add(tick).Format(dateFormat)
 
 		buffers := []string{
 			start + " [tasker] final tick on or before " + end,
@@ -99,6 +117,215 @@ func TestRun(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Run with FakeClock", func(t *testing.T) {
+		ref, _ := time.Parse("2006-01-02 15:04:05", "2021-04-19 12:54:30")
+		clock := gronx.NewFakeClock(ref)
+		taskr := New(Option{Tz: "UTC"}).WithClock(clock)
+
+		var called int32
+		taskr.Task("*/2 * * * *", func(_ context.Context) (int, error) {
+			atomic.AddInt32(&called, 1)
+			return 0, nil
+		})
+
+		done := make(chan bool)
+		go func() {
+			taskr.Until(10 * time.Minute).Run()
+			done <- true
+		}()
+
+		for {
+			select {
+			case <-done:
+				if n := atomic.LoadInt32(&called); n != 5 {
+					t.Errorf("task should run 5 times, ran %d times", n)
+				}
+				return
+			default:
+			}
+			if clock.Timers() > 0 {
+				clock.Advance(time.Second)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("Run sleeps until due", func(t *testing.T) {
+		ref, _ := time.Parse("2006-01-02 15:04:05", "2021-04-19 12:54:30")
+		clock := gronx.NewFakeClock(ref)
+		taskr := New(Option{Tz: "UTC"}).WithClock(clock)
+
+		var called int32
+		taskr.Task("0 13 * * *", func(_ context.Context) (int, error) {
+			atomic.AddInt32(&called, 1)
+			return 0, nil
+		})
+
+		done := make(chan bool)
+		go func() {
+			taskr.Until(time.Hour).Run()
+			done <- true
+		}()
+
+		for clock.Timers() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		// A single timer till 13:00 instead of one per minute
+		clock.Advance(6 * time.Minute)
+		for atomic.LoadInt32(&called) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		for {
+			select {
+			case <-done:
+				if n := atomic.LoadInt32(&called); n != 1 {
+					t.Errorf("task should run once, ran %d times", n)
+				}
+				return
+			default:
+			}
+			if clock.Timers() > 0 {
+				clock.Advance(time.Minute)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("Run sub-second", func(t *testing.T) {
+		ref, _ := time.Parse("2006-01-02 15:04:05", "2021-04-19 12:54:30")
+		clock := gronx.NewFakeClock(ref)
+		taskr := New(Option{Tz: "UTC"}).WithClock(clock)
+
+		var fine, coarse int32
+		taskr.Task("@every 250ms", func(_ context.Context) (int, error) {
+			atomic.AddInt32(&fine, 1)
+			return 0, nil
+		}).Task("* * * * * *", func(_ context.Context) (int, error) {
+			atomic.AddInt32(&coarse, 1)
+			return 0, nil
+		})
+
+		done := make(chan bool)
+		go func() {
+			taskr.Until(2 * time.Second).Run()
+			done <- true
+		}()
+
+		for {
+			select {
+			case <-done:
+				if taskr.tick != 250*time.Millisecond {
+					t.Errorf("tick should be 250ms, got %s", taskr.tick)
+				}
+				if other := New(Option{Tz: "UTC"}); other.tick != time.Minute {
+					t.Errorf("tick of other tasker should stay 1m, got %s", other.tick)
+				}
+				if n := atomic.LoadInt32(&fine); n != 8 {
+					t.Errorf("sub-second task should run 8 times, ran %d times", n)
+				}
+				if n := atomic.LoadInt32(&coarse); n != 2 {
+					t.Errorf("every second task should run 2 times, ran %d times", n)
+				}
+				return
+			default:
+			}
+			if clock.Timers() > 0 {
+				clock.Advance(50 * time.Millisecond)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("Run with epoch", func(t *testing.T) {
+		ref, _ := time.Parse("2006-01-02 15:04:05", "2021-04-19 12:00:00")
+		clock := gronx.NewFakeClock(ref)
+		taskr := New(Option{Tz: "UTC"}).WithClock(clock)
+
+		var mu sync.Mutex
+		runs := map[string][]string{}
+		record := func(name string) TaskFunc {
+			return func(_ context.Context) (int, error) {
+				mu.Lock()
+				runs[name] = append(runs[name], clock.Now().Format("15:04"))
+				mu.Unlock()
+				return 0, nil
+			}
+		}
+		taskr.Task("@every 2m", record("default")).
+			TaskFrom("@every 2m", ref.Add(time.Minute), record("anchored"))
+
+		done := make(chan bool)
+		go func() {
+			taskr.Until(4 * time.Minute).Run()
+			done <- true
+		}()
+
+		for {
+			select {
+			case <-done:
+				mu.Lock()
+				defer mu.Unlock()
+				if actual := strings.Join(runs["default"], ","); actual != "12:02,12:04" {
+					t.Errorf("default task should run on 12:02,12:04, ran on %s", actual)
+				}
+				if actual := strings.Join(runs["anchored"], ","); actual != "12:01,12:03" {
+					t.Errorf("anchored task should run on 12:01,12:03, ran on %s", actual)
+				}
+				return
+			default:
+			}
+			if clock.Timers() > 0 {
+				clock.Advance(time.Second)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("Run with splay", func(t *testing.T) {
+		ref, _ := time.Parse("2006-01-02 15:04:05", "2021-04-19 12:54:30")
+		clock := gronx.NewFakeClock(ref)
+		taskr := New(Option{Tz: "UTC"}).WithClock(clock).WithSplay(time.Minute, "host1")
+
+		var mu sync.Mutex
+		var runs []time.Time
+		taskr.Task("*/2 * * * *", func(_ context.Context) (int, error) {
+			mu.Lock()
+			runs = append(runs, clock.Now())
+			mu.Unlock()
+			return 0, nil
+		})
+
+		done := make(chan bool)
+		go func() {
+			taskr.Until(4 * time.Minute).Run()
+			done <- true
+		}()
+
+		delay := gronx.Splay(time.Minute, "host1", "[*/2 * * * *][#1]")
+		for {
+			select {
+			case <-done:
+				mu.Lock()
+				defer mu.Unlock()
+				if len(runs) == 0 {
+					t.Fatalf("task should run")
+				}
+				for _, run := range runs {
+					if run.Sub(run.Truncate(2*time.Minute)) != delay {
+						t.Errorf("task should run %s after tick, ran on %s", delay, run)
+					}
+				}
+				return
+			default:
+			}
+			if clock.Timers() > 0 {
+				clock.Advance(time.Second)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
 }
 
 func TestTaskify(t *testing.T) {
@@ -129,7 +356,7 @@ func TestTaskify(t *testing.T) {
 }
 
 func TestWithContext(t *testing.T) {
-	// tickSec = 2
+	// tick = 2 * time.Second
 	t.Run("WithContext", func(t *testing.T) {
 		os.Remove("../../test/tasker-ctx.out")
 		ctx, cancel := context.WithCancel(context.Background())
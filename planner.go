@@ -0,0 +1,189 @@
+package gronx
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Planner holds many schedules keyed by ID and tells which of them fire next.
+// Add, Update and Remove are O(log n) and Peek is O(1) (plus the IDs firing together).
+// It is safe for concurrent use.
+type Planner struct {
+	gron  *Gronx
+	queue planQueue
+	items map[string]*planItem
+	mu    sync.Mutex
+}
+
+type planItem struct {
+	id    string
+	sched *Schedule
+	next  time.Time
+	index int
+}
+
+// planQueue is min-heap of planItem by next fire time.
+type planQueue []*planItem
+
+func (q planQueue) Len() int { return len(q) }
+
+func (q planQueue) Less(i, j int) bool {
+	if q[i].next.Equal(q[j].next) {
+		return q[i].id < q[j].id
+	}
+	return q[i].next.Before(q[j].next)
+}
+
+func (q planQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index, q[j].index = i, j
+}
+
+func (q *planQueue) Push(x interface{}) {
+	item := x.(*planItem)
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *planQueue) Pop() interface{} {
+	old, n := *q, len(*q)
+	item := old[n-1]
+	old[n-1], item.index = nil, -1
+	*q = old[0 : n-1]
+	return item
+}
+
+// NewPlanner gives a Planner with factory default Gronx.
+func NewPlanner() *Planner {
+	return New().Planner()
+}
+
+// Planner gives a Planner whose schedules respect Calendar and CalendarSystem of Gronx.
+func (g *Gronx) Planner() *Planner {
+	return &Planner{gron: g, items: map[string]*planItem{}}
+}
+
+// Add plans cron expr by id to fire next after given time (or now).
+// If id is already planned, it is updated.
+// It returns error if expr is invalid or never fires again.
+func (p *Planner) Add(id, expr string, ref ...time.Time) error {
+	sched, err := p.gron.Compile(expr)
+	if err != nil {
+		return err
+	}
+	return p.AddSchedule(id, sched, ref...)
+}
+
+// AddSchedule plans compiled Schedule by id to fire next after given time (or now),
+// eg: a Schedule compiled by other Gronx with its own Epoch.
+// If id is already planned, it is updated.
+// It returns error if schedule never fires again.
+func (p *Planner) AddSchedule(id string, sched *Schedule, ref ...time.Time) error {
+	ref = append(ref, p.gron.now())
+	next, err := sched.NextTickAfter(ref[0], false)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if item, ok := p.items[id]; ok {
+		item.sched, item.next = sched, next
+		heap.Fix(&p.queue, item.index)
+		return nil
+	}
+
+	item := &planItem{id: id, sched: sched, next: next}
+	p.items[id] = item
+	heap.Push(&p.queue, item)
+	return nil
+}
+
+// Update replans id with new cron expr. It is alias of Add.
+func (p *Planner) Update(id, expr string, ref ...time.Time) error {
+	return p.Add(id, expr, ref...)
+}
+
+// Remove unplans id.
+// It returns false if id was not planned.
+func (p *Planner) Remove(id string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	item, ok := p.items[id]
+	if ok {
+		heap.Remove(&p.queue, item.index)
+		delete(p.items, id)
+	}
+	return ok
+}
+
+// Len gives the number of planned ids.
+func (p *Planner) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.queue)
+}
+
+// Next gives next fire time of id.
+// It returns false if id is not planned.
+func (p *Planner) Next(id string) (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if item, ok := p.items[id]; ok {
+		return item.next, true
+	}
+	return time.Time{}, false
+}
+
+// Peek gives the soonest fire time and all ids (sorted) that fire at that time.
+// It returns false if nothing is planned.
+func (p *Planner) Peek() (time.Time, []string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.queue) == 0 {
+		return time.Time{}, nil, false
+	}
+
+	next := p.queue[0].next
+	ids := p.collect(0, next, nil)
+	sort.Strings(ids)
+	return next, ids, true
+}
+
+// collect walks the heap from index i for items firing at next.
+func (p *Planner) collect(i int, next time.Time, ids []string) []string {
+	if i >= len(p.queue) || !p.queue[i].next.Equal(next) {
+		return ids
+	}
+	ids = append(ids, p.queue[i].id)
+	return p.collect(2*i+2, next, p.collect(2*i+1, next, ids))
+}
+
+// Advance pops all ids that are due at or before given time and
+// replans each of them to fire next after that time.
+// The ids that never fire again (or fail to replan) are removed.
+// It returns the ids (in order of their fire time) that were due, and the ids that were removed.
+func (p *Planner) Advance(now time.Time) (ids, removed []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var done []*planItem
+	for len(p.queue) > 0 && !p.queue[0].next.After(now) {
+		item := heap.Pop(&p.queue).(*planItem)
+		ids, done = append(ids, item.id), append(done, item)
+	}
+
+	for _, item := range done {
+		next, err := item.sched.NextTickAfter(now, false)
+		if err != nil || !next.After(now) {
+			delete(p.items, item.id)
+			removed = append(removed, item.id)
+			continue
+		}
+		item.next = next
+		heap.Push(&p.queue, item)
+	}
+	return ids, removed
+}
@@ -0,0 +1,96 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlanner(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+
+	t.Run("add peek advance", func(t *testing.T) {
+		plan := NewPlanner()
+		for id, expr := range map[string]string{"a": "*/5 * * * *", "b": "0 13 * * *", "c": "55 12 * * *", "d": "@hourly"} {
+			if err := plan.Add(id, expr, ref); err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+		}
+		if plan.Len() != 4 {
+			t.Errorf("expected 4, got %d", plan.Len())
+		}
+
+		next, ids, ok := plan.Peek()
+		if !ok || next.Format(FullDateFormat) != "2021-04-19 12:55:00" || strings.Join(ids, ",") != "a,c" {
+			t.Errorf("expected a,c on 2021-04-19 12:55:00, got %v on %v", ids, next)
+		}
+
+		if ids, _ := plan.Advance(next.Add(-time.Second)); len(ids) != 0 {
+			t.Errorf("expected none, got %v", ids)
+		}
+		if ids, _ := plan.Advance(next); len(ids) != 2 {
+			t.Errorf("expected 2 ids, got %v", ids)
+		}
+
+		next, ids, _ = plan.Peek()
+		if next.Format(FullDateFormat) != "2021-04-19 13:00:00" || strings.Join(ids, ",") != "a,b,d" {
+			t.Errorf("expected a,b,d on 2021-04-19 13:00:00, got %v on %v", ids, next)
+		}
+		if next, _ := plan.Next("c"); next.Format(FullDateFormat) != "2021-04-20 12:55:00" {
+			t.Errorf("expected c next on 2021-04-20 12:55:00, got %v", next)
+		}
+	})
+
+	t.Run("update remove", func(t *testing.T) {
+		plan := NewPlanner()
+		plan.Add("a", "0 0 * * *", ref)
+		plan.Add("b", "0 0 1 * *", ref)
+		if err := plan.Update("b", "* * * * *", ref); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if _, ids, _ := plan.Peek(); len(ids) != 1 || ids[0] != "b" {
+			t.Errorf("expected b, got %v", ids)
+		}
+
+		if !plan.Remove("b") || plan.Remove("b") {
+			t.Error("expected b to be removed once")
+		}
+		if _, ids, _ := plan.Peek(); len(ids) != 1 || ids[0] != "a" {
+			t.Errorf("expected a, got %v", ids)
+		}
+		if _, ok := plan.Next("b"); ok {
+			t.Error("expected b not to be planned")
+		}
+
+		plan.Remove("a")
+		if _, _, ok := plan.Peek(); ok {
+			t.Error("expected empty plan")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		plan := NewPlanner()
+		if err := plan.Add("a", "* * *", ref); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+		if err := plan.Add("a", "0 0 1 1 * 2018", ref); err == nil {
+			t.Error("expected error for expr that never fires")
+		}
+
+		plan.Add("b", "0 0 1 1 * 2022", ref)
+		ids, removed := plan.Advance(ref.AddDate(1, 0, 0))
+		if len(ids) != 1 || plan.Len() != 0 || strings.Join(removed, ",") != "b" {
+			t.Errorf("expected b to fire and be removed, got %v, removed %v", ids, removed)
+		}
+	})
+	t.Run("add schedule", func(t *testing.T) {
+		plan := NewPlanner()
+		sched, _ := New().WithEpoch(ref.Add(-time.Minute)).Compile("@every 3m")
+		if err := plan.AddSchedule("a", sched, ref); err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if next, _ := plan.Next("a"); next.Format(FullDateFormat) != "2021-04-19 12:56:00" {
+			t.Errorf("expected a next on 2021-04-19 12:56:00, got %v", next)
+		}
+	})
+}
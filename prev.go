@@ -7,26 +7,54 @@ import (
 
 // PrevTick gives previous run time before now
 func PrevTick(expr string, inclRefTime bool) (time.Time, error) {
-	return PrevTickBefore(expr, time.Now(), inclRefTime)
+	return New().PrevTick(expr, inclRefTime)
+}
+
+// PrevTick gives previous run time before now (as per Clock)
+func (g *Gronx) PrevTick(expr string, inclRefTime bool) (time.Time, error) {
+	return g.PrevTickBefore(expr, g.now(), inclRefTime)
 }
 
 // PrevTickBefore gives previous run time before given reference time
 func PrevTickBefore(expr string, start time.Time, inclRefTime bool) (time.Time, error) {
-	gron, prev := New(), start.Truncate(time.Second)
-	due, err := gron.IsDue(expr, start)
+	return New().PrevTickBefore(expr, start, inclRefTime)
+}
+
+// PrevTickBefore gives previous run time before given reference time
+// skipping the days excluded by Calendar if any.
+func (g *Gronx) PrevTickBefore(expr string, start time.Time, inclRefTime bool) (time.Time, error) {
+	segments, err := g.segments(expr)
+	if err != nil {
+		return start.Truncate(time.Second), err
+	}
+	return g.tickBefore(segments, start, inclRefTime)
+}
+
+// tickBefore gives previous run time of cron segments before given reference time
+func (g *Gronx) tickBefore(segments []string, start time.Time, inclRefTime bool) (time.Time, error) {
+	prev := start.Truncate(time.Second)
+	if segments[0] == everyTag {
+		dur, err := parseEvery(segments[1])
+		if err != nil {
+			return prev, err
+		}
+		return g.everyLoop(dur, start, inclRefTime, true)
+	}
+
+	due, err := g.segmentsDue(segments, start)
 	if err != nil || (due && inclRefTime) {
 		return prev, err
 	}
-
-	segments, _ := Segments(expr)
-	if len(segments) > 6 && isUnreachableYear(segments[6], prev, true) {
+	if len(segments) > 6 && isUnreachableYear(segments[6], g.system().Value(prev, 6), true) {
 		return prev, fmt.Errorf("unreachable year segment: %s", segments[6])
 	}
 
-	prev, err = loop(gron, segments, prev, inclRefTime, true)
-	// Ignore superfluous err
-	if err != nil && gron.isDue(expr, prev) {
-		err = nil
+	prev, err = loop(g, segments, prev, inclRefTime, true)
+	// Ignore superfluous err, unless search could not move off the ref time
+	if err != nil {
+		if due, _ := g.segmentsDue(segments, prev); due && !prev.Equal(start.Truncate(time.Second)) {
+			err = nil
+		}
 	}
 	return prev, err
 }
@@ -43,7 +71,7 @@ func bumpReverse(ref time.Time, pos int) time.Time {
 	case 2:
 		hTime := ref.Add(-time.Hour)
 		ref = time.Date(hTime.Year(), hTime.Month(), hTime.Day(), hTime.Hour(), 59, 59, 0, loc)
-	case 3, 5:
+	case 3, 5, 7, 8:
 		dTime := ref.AddDate(0, 0, -1)
 		ref = time.Date(dTime.Year(), dTime.Month(), dTime.Day(), 23, 59, 59, 0, loc)
 	case 4:
@@ -0,0 +1,196 @@
+package gronx
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Schedule is a pre-parsed cron expr for repeated checks without re-parsing it.
+// Its IsDue is allocation free and safe for concurrent use for expressions without
+// modifiers (`L`, `W`, `BD`, `#` or custom ones) on Gregorian CalendarSystem.
+type Schedule struct {
+	Expr  string
+	segs  []string
+	gron  *Gronx
+	bits  [9][]uint64
+	every time.Duration
+	union bool
+	fast  bool
+	loc   *time.Location
+	mu    sync.Mutex
+}
+
+// Compile parses cron expr into a Schedule.
+// It returns Schedule or error if expr is invalid.
+func Compile(expr string) (*Schedule, error) {
+	return New().Compile(expr)
+}
+
+// Compile parses cron expr into a Schedule that respects Calendar and
+// CalendarSystem of Gronx (as of now).
+// It returns Schedule or error if expr is invalid.
+func (g *Gronx) Compile(expr string) (*Schedule, error) {
+	segs, err := g.segments(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	sched := &Schedule{Expr: expr, segs: segs, gron: g.clone()}
+	if segs[0] == everyTag {
+		sched.every, err = parseEvery(segs[1])
+		sched.fast = err == nil
+		return sched, err
+	}
+
+	for pos, seg := range segs {
+		if _, err := sched.gron.C.CheckDue(seg, pos); err != nil {
+			return nil, err
+		}
+	}
+
+	sched.fast = sched.compile()
+	return sched, nil
+}
+
+// compile computes bitsets of due values per segment.
+// It returns false if expr can't be checked with bitsets.
+func (s *Schedule) compile() bool {
+	c, ok := s.gron.C.(*SegmentChecker)
+	if _, greg := systemOf(s.gron.C).(Gregorian); !ok || !greg {
+		return false
+	}
+
+	for pos, seg := range s.segs {
+		if s.bits[pos], ok = segmentBits(c, seg, pos); !ok {
+			return false
+		}
+	}
+
+	monthDaySeg, weekDaySeg := s.segs[3], s.segs[5]
+	s.union = weekDaySeg != "*" && weekDaySeg != "?" && !(strings.Index(weekDaySeg, "*/") == 0 ||
+		strings.Index(monthDaySeg, "*") == 0 || monthDaySeg == "?")
+
+	return true
+}
+
+// segmentBits computes bitset of due values of a segment, nil if all values are due.
+// It returns false if segment can't be checked with bitset.
+func segmentBits(c *SegmentChecker, seg string, pos int) ([]uint64, bool) {
+	if pos > 6 {
+		seg = strings.ReplaceAll(seg, extPrefix[pos-7], "")
+	}
+	if seg == "*" || seg == "?" {
+		return nil, true
+	}
+
+	offsets := strings.Split(seg, ",")
+	for _, offset := range offsets {
+		if _, custom := modifierFor(offset, pos); custom || ((pos == 3 || pos == 5) && strings.ContainsAny(offset, "LWB#")) {
+			return nil, false
+		}
+	}
+
+	bounds := boundsByPos(pos)
+	if pos == 5 {
+		bounds = []int{0, 6}
+	}
+	bits := make([]uint64, bounds[1]/64+1)
+	for val := bounds[0]; val <= bounds[1]; val++ {
+		for _, offset := range offsets {
+			if due, _ := c.isOffsetDue(offset, val, pos); due {
+				bits[val>>6] |= 1 << uint(val&63)
+				break
+			}
+		}
+	}
+	return bits, true
+}
+
+// clock gives the times of day (since midnight, ascending) the <hour>, <minute> and <second> segments are due.
+// It returns false if they can't be worked out without a reference time, eg: with custom modifiers.
+func (s *Schedule) clock() ([]time.Duration, bool) {
+	var bits [3][]uint64
+	if s.fast {
+		copy(bits[:], s.bits[:3])
+	} else {
+		c, ok := s.gron.C.(*SegmentChecker)
+		for pos := 0; ok && pos < 3; pos++ {
+			bits[pos], ok = segmentBits(c, s.segs[pos], pos)
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+
+	var clock []time.Duration
+	has := func(pos, val int) bool { return bits[pos] == nil || bits[pos][val>>6]&(1<<uint(val&63)) != 0 }
+	for hour := 0; hour < 24; hour++ {
+		for minute := 0; minute < 60 && has(2, hour); minute++ {
+			for second := 0; second < 60 && has(1, minute); second++ {
+				if has(0, second) {
+					clock = append(clock, time.Duration(hour*3600+minute*60+second)*time.Second)
+				}
+			}
+		}
+	}
+	return clock, true
+}
+
+func (s *Schedule) has(pos, val int) bool {
+	bits := s.bits[pos]
+	return bits == nil || (val>>6 < len(bits) && bits[val>>6]&(1<<uint(val&63)) != 0)
+}
+
+// IsDue checks if the schedule is due for given time.
+func (s *Schedule) IsDue(ref time.Time) bool {
+	if !s.fast {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		due, err := s.gron.segmentsDue(s.segs, ref)
+		return err == nil && due
+	}
+	if s.every > 0 {
+		return s.gron.everyDue(s.every, ref) && !s.gron.isExcluded(ref)
+	}
+
+	year, month, day := ref.Date()
+	hour, minute, second := ref.Clock()
+	if !s.has(0, second) || !s.has(1, minute) || !s.has(2, hour) || !s.has(4, int(month)) || !s.has(6, year) {
+		return false
+	}
+	if s.bits[7] != nil {
+		if _, week := ref.ISOWeek(); !s.has(7, week) {
+			return false
+		}
+	}
+	if s.bits[8] != nil && !s.has(8, ref.YearDay()) {
+		return false
+	}
+
+	monthDay, weekDay := s.has(3, day), s.has(5, int(ref.Weekday()))
+	if s.union && !(monthDay || weekDay) || !s.union && !(monthDay && weekDay) {
+		return false
+	}
+
+	return !s.gron.isExcluded(ref)
+}
+
+// NextTickAfter gives next run time of the schedule from the provided time.Time
+func (s *Schedule) NextTickAfter(start time.Time, inclRefTime bool) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gron.tickAfter(s.segs, start, inclRefTime)
+}
+
+// PrevTickBefore gives previous run time of the schedule before given reference time
+func (s *Schedule) PrevTickBefore(start time.Time, inclRefTime bool) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gron.tickBefore(s.segs, start, inclRefTime)
+}
+
+// String gives the canonical form of the schedule.
+func (s *Schedule) String() string {
+	return strings.Join(s.segs, " ")
+}
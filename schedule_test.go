@@ -0,0 +1,95 @@
+package gronx
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSchedule(t *testing.T) {
+	gron := New()
+
+	for i, test := range testcases() {
+		t.Run(fmt.Sprintf("is due #%d=%s", i, test.Expr), func(t *testing.T) {
+			ref, _ := time.Parse(FullDateFormat, test.Ref)
+			expect, err := gron.IsDue(test.Expr, ref)
+			sched, cerr := Compile(test.Expr)
+			if cerr != nil {
+				if err == nil {
+					t.Errorf("expected nil, got %v", cerr)
+				}
+				return
+			}
+			if actual := sched.IsDue(ref); actual != expect {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+
+	for i, test := range errcases() {
+		t.Run(fmt.Sprintf("compile err #%d=%s", i, test.Expr), func(t *testing.T) {
+			if _, err := Compile(test.Expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+
+	t.Run("next and prev tick", func(t *testing.T) {
+		ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+		for _, expr := range []string{"0 9 * * MON-FRI", "*/7 * * * *", "0 0 L * *", "@every 90m"} {
+			sched, _ := Compile(expr)
+			next, _ := sched.NextTickAfter(ref, false)
+			if expect, _ := NextTickAfter(expr, ref, false); !next.Equal(expect) {
+				t.Errorf("%s: expected next %v, got %v", expr, expect, next)
+			}
+			prev, _ := sched.PrevTickBefore(ref, false)
+			if expect, _ := PrevTickBefore(expr, ref, false); !prev.Equal(expect) {
+				t.Errorf("%s: expected prev %v, got %v", expr, expect, prev)
+			}
+		}
+	})
+
+	t.Run("calendar", func(t *testing.T) {
+		cal := DateCalendar{"2021-12-25": true}
+		sched, _ := New().WithCalendar(cal).Compile("0 0 * * *")
+		ref, _ := time.Parse(FullDateFormat, "2021-12-25 00:00:00")
+		if sched.IsDue(ref) {
+			t.Errorf("expected excluded day %s not to be due", ref)
+		}
+		if !sched.IsDue(ref.AddDate(0, 0, 1)) {
+			t.Errorf("expected %s to be due", ref.AddDate(0, 0, 1))
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		sched, _ := Compile("@daily")
+		if actual := sched.String(); actual != "0 0 0 * * *" {
+			t.Errorf("expected 0 0 0 * * *, got %s", actual)
+		}
+	})
+
+	t.Run("zero alloc", func(t *testing.T) {
+		sched, _ := Compile("*/5 9-17 * * MON-FRI")
+		ref := time.Date(2021, 4, 19, 12, 55, 0, 0, time.UTC)
+		if allocs := testing.AllocsPerRun(100, func() { sched.IsDue(ref) }); allocs != 0 {
+			t.Errorf("expected 0 allocs, got %v", allocs)
+		}
+	})
+}
+
+func BenchmarkIsDue(b *testing.B) {
+	gron, ref := New(), time.Date(2021, 4, 19, 12, 55, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		gron.IsDue("*/5 9-17 * * MON-FRI", ref)
+	}
+}
+
+func BenchmarkScheduleIsDue(b *testing.B) {
+	sched, _ := Compile("*/5 9-17 * * MON-FRI")
+	ref := time.Date(2021, 4, 19, 12, 55, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sched.IsDue(ref)
+	}
+}
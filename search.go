@@ -0,0 +1,99 @@
+package gronx
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrHorizon is the error when there is no tick within the search Horizon.
+var ErrHorizon = errors.New("no tick within search horizon")
+
+// SearchOption bounds the work of finding next or prev tick.
+type SearchOption struct {
+	// Horizon is how far to look ahead (or back) from start, eg: 5 years. Zero means no limit.
+	Horizon time.Duration
+	// MaxIter is the max iterations of search loop. Zero means the default of 500.
+	MaxIter int
+}
+
+// search is the bound of ongoing search of tick.
+type search struct {
+	ctx     context.Context
+	start   time.Time
+	horizon time.Duration
+	iter    int
+}
+
+// check tells if search may go on at ref.
+// It returns error if context is done or ref is beyond horizon.
+func (s *search) check(ref time.Time) error {
+	if s == nil {
+		return nil
+	}
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	if s.horizon > 0 && (ref.Sub(s.start) > s.horizon || s.start.Sub(ref) > s.horizon) {
+		return ErrHorizon
+	}
+	return nil
+}
+
+// iterations gives max iterations of search loop.
+func (s *search) iterations() int {
+	if s == nil || s.iter <= 0 {
+		return 500
+	}
+	return s.iter
+}
+
+// NextTickAfterCtx gives next run time from the provided time.Time, giving up
+// when ctx is done or search goes beyond Horizon of SearchOption.
+// It returns time or error (ctx.Err() or ErrHorizon if it gave up).
+func NextTickAfterCtx(ctx context.Context, expr string, start time.Time, inclRefTime bool, opt ...SearchOption) (time.Time, error) {
+	return New().NextTickAfterCtx(ctx, expr, start, inclRefTime, opt...)
+}
+
+// NextTickAfterCtx gives next run time from the provided time.Time skipping the days
+// excluded by Calendar if any, giving up when ctx is done or search goes beyond Horizon.
+// It returns time or error (ctx.Err() or ErrHorizon if it gave up).
+func (g *Gronx) NextTickAfterCtx(ctx context.Context, expr string, start time.Time, inclRefTime bool, opt ...SearchOption) (time.Time, error) {
+	segments, err := g.segments(expr)
+	if err != nil {
+		return start, err
+	}
+	if err := ctx.Err(); err != nil {
+		return start, err
+	}
+	return g.bounded(ctx, start, opt).tickAfter(segments, start, inclRefTime)
+}
+
+// PrevTickBeforeCtx gives prev run time before the provided time.Time, giving up
+// when ctx is done or search goes beyond Horizon of SearchOption.
+// It returns time or error (ctx.Err() or ErrHorizon if it gave up).
+func PrevTickBeforeCtx(ctx context.Context, expr string, start time.Time, inclRefTime bool, opt ...SearchOption) (time.Time, error) {
+	return New().PrevTickBeforeCtx(ctx, expr, start, inclRefTime, opt...)
+}
+
+// PrevTickBeforeCtx gives prev run time before the provided time.Time skipping the days
+// excluded by Calendar if any, giving up when ctx is done or search goes beyond Horizon.
+// It returns time or error (ctx.Err() or ErrHorizon if it gave up).
+func (g *Gronx) PrevTickBeforeCtx(ctx context.Context, expr string, start time.Time, inclRefTime bool, opt ...SearchOption) (time.Time, error) {
+	segments, err := g.segments(expr)
+	if err != nil {
+		return start.Truncate(time.Second), err
+	}
+	if err := ctx.Err(); err != nil {
+		return start.Truncate(time.Second), err
+	}
+	return g.bounded(ctx, start, opt).tickBefore(segments, start, inclRefTime)
+}
+
+// bounded gives a copy of Gronx whose search of ticks is bounded by ctx and SearchOption.
+func (g *Gronx) bounded(ctx context.Context, start time.Time, opt []SearchOption) *Gronx {
+	opt = append(opt, SearchOption{})
+	gron := g.clone()
+	gron.search = &search{ctx: ctx, start: start, horizon: opt[0].Horizon, iter: opt[0].MaxIter}
+	return gron
+}
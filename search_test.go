@@ -0,0 +1,72 @@
+package gronx
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTickCtx(t *testing.T) {
+	ref, _ := time.Parse(FullDateFormat, "2021-04-19 12:54:00")
+	year := 366 * 24 * time.Hour
+
+	t.Run("within horizon", func(t *testing.T) {
+		next, err := NextTickAfterCtx(context.Background(), "0 0 29 2 *", ref, false, SearchOption{Horizon: 5 * year})
+		if expect := "2024-02-29 00:00:00"; err != nil || next.Format(FullDateFormat) != expect {
+			t.Errorf("expected %s, got %s (err: %v)", expect, next.Format(FullDateFormat), err)
+		}
+		prev, err := PrevTickBeforeCtx(context.Background(), "0 0 29 2 *", ref, false, SearchOption{Horizon: 5 * year})
+		if expect := "2020-02-29 00:00:00"; err != nil || prev.Format(FullDateFormat) != expect {
+			t.Errorf("expected %s, got %s (err: %v)", expect, prev.Format(FullDateFormat), err)
+		}
+	})
+
+	t.Run("beyond horizon", func(t *testing.T) {
+		for _, expr := range []string{"0 0 29 2 *", "0 0 30 2 *", "@every 8760h"} {
+			if _, err := NextTickAfterCtx(context.Background(), expr, ref, false, SearchOption{Horizon: 24 * time.Hour}); err != ErrHorizon {
+				t.Errorf("%s: expected ErrHorizon, got %v", expr, err)
+			}
+			if _, err := PrevTickBeforeCtx(context.Background(), expr, ref, false, SearchOption{Horizon: 24 * time.Hour}); err != ErrHorizon {
+				t.Errorf("%s: expected ErrHorizon, got %v", expr, err)
+			}
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := NextTickAfterCtx(ctx, "* * * * *", ref, false); err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if _, err := PrevTickBeforeCtx(ctx, "* * * * *", ref, false); err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		time.Sleep(2 * time.Millisecond)
+		if _, err := NextTickAfterCtx(ctx, "0 0 30 2 *", ref, false); err != context.DeadlineExceeded {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("max iter", func(t *testing.T) {
+		if _, err := NextTickAfterCtx(context.Background(), "0 0 29 2 *", ref, false, SearchOption{MaxIter: 1}); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("no bound", func(t *testing.T) {
+		gron := New()
+		next, err := gron.NextTickAfterCtx(context.Background(), "0 9 * * MON", ref, false)
+		expect, _ := gron.NextTickAfter("0 9 * * MON", ref, false)
+		if err != nil || !next.Equal(expect) {
+			t.Errorf("expected %s, got %s (err: %v)", expect, next, err)
+		}
+		if gron.search != nil {
+			t.Errorf("expected gron to stay unbounded")
+		}
+	})
+}
@@ -0,0 +1,242 @@
+package gronx
+
+import (
+	"time"
+)
+
+// CalendarSystem maps time to the segment values of a calendar,
+// eg: fiscal year starting in April or 4-4-5 retail calendar.
+type CalendarSystem interface {
+	// Value gives the value of segment at pos for ref.
+	Value(ref time.Time, pos int) int
+	// Bounds gives the min and max value of segment at pos.
+	Bounds(pos int) []int
+	// LastDay gives the number of days in the month (or period) of ref.
+	LastDay(ref time.Time) int
+	// Bump gives the start of next (or end of prev if reverse) unit of segment at pos.
+	Bump(ref time.Time, pos int, reverse bool) time.Time
+}
+
+// Gregorian is the factory default CalendarSystem.
+type Gregorian struct{}
+
+// Value gives the value of segment at pos for ref.
+func (Gregorian) Value(ref time.Time, pos int) int {
+	return valueByPos(ref, pos)
+}
+
+// Bounds gives the min and max value of segment at pos.
+func (Gregorian) Bounds(pos int) []int {
+	return boundsByPos(pos)
+}
+
+// LastDay gives the number of days in the month of ref.
+func (Gregorian) LastDay(ref time.Time) int {
+	return time.Date(ref.Year(), ref.Month(), 1, 0, 0, 0, 0, ref.Location()).AddDate(0, 1, 0).Add(-time.Second).Day()
+}
+
+// Bump gives the start of next (or end of prev if reverse) unit of segment at pos.
+func (Gregorian) Bump(ref time.Time, pos int, reverse bool) time.Time {
+	if reverse {
+		return bumpReverse(ref, pos)
+	}
+	return bump(ref, pos)
+}
+
+// Fiscal is the Gregorian calendar whose year starts in StartMonth (January if zero).
+// The <month> 1 is the StartMonth and the <year> is the year in which fiscal year starts.
+type Fiscal struct {
+	StartMonth time.Month
+}
+
+func (f Fiscal) start() time.Month {
+	if f.StartMonth < time.January || f.StartMonth > time.December {
+		return time.January
+	}
+	return f.StartMonth
+}
+
+func (f Fiscal) year(ref time.Time) int {
+	if ref.Month() < f.start() {
+		return ref.Year() - 1
+	}
+	return ref.Year()
+}
+
+// Value gives the value of segment at pos for ref.
+func (f Fiscal) Value(ref time.Time, pos int) int {
+	switch pos {
+	case 4:
+		return (int(ref.Month())-int(f.start())+12)%12 + 1
+	case 6:
+		return f.year(ref)
+	case 8:
+		return civilDays(time.Date(f.year(ref), f.start(), 1, 0, 0, 0, 0, ref.Location()), ref) + 1
+	}
+	return valueByPos(ref, pos)
+}
+
+// Bounds gives the min and max value of segment at pos.
+func (f Fiscal) Bounds(pos int) []int {
+	return boundsByPos(pos)
+}
+
+// LastDay gives the number of days in the month of ref.
+func (f Fiscal) LastDay(ref time.Time) int {
+	return Gregorian{}.LastDay(ref)
+}
+
+// Bump gives the start of next (or end of prev if reverse) unit of segment at pos.
+func (f Fiscal) Bump(ref time.Time, pos int, reverse bool) time.Time {
+	if pos != 6 {
+		return Gregorian{}.Bump(ref, pos, reverse)
+	}
+
+	start := time.Date(f.year(ref), f.start(), 1, 0, 0, 0, 0, ref.Location())
+	if reverse {
+		return start.Add(-time.Second)
+	}
+	return start.AddDate(1, 0, 0)
+}
+
+// Retail is a 52-53 week retail calendar whose quarters are split into periods of
+// Pattern weeks (4-4-5 if zero). The year starts on Weekday nearest to StartMonth
+// and StartDay (January 1 if zero) and the 53rd week if any is added to last period.
+// The <day> is the day of period, <month> is the period (1-12), <year> is the year
+// in which retail year starts, ISO week is the week of year and day of year is the
+// day of retail year.
+type Retail struct {
+	Pattern    [3]int
+	StartMonth time.Month
+	StartDay   int
+	Weekday    time.Weekday
+}
+
+func (r Retail) pattern() [3]int {
+	if r.Pattern[0]+r.Pattern[1]+r.Pattern[2] != 13 {
+		return [3]int{4, 4, 5}
+	}
+	return r.Pattern
+}
+
+func (r Retail) yearStart(year int, loc *time.Location) time.Time {
+	month, day := r.StartMonth, r.StartDay
+	if month < time.January || month > time.December {
+		month = time.January
+	}
+	if day < 1 {
+		day = 1
+	}
+
+	date := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	diff := (int(r.Weekday) - int(date.Weekday()) + 7) % 7
+	if diff > 3 {
+		diff -= 7
+	}
+	return date.AddDate(0, 0, diff)
+}
+
+// locate gives the retail year of ref and its start and end.
+func (r Retail) locate(ref time.Time) (year int, start, end time.Time) {
+	year, loc := ref.Year(), ref.Location()
+	start, end = r.yearStart(year, loc), r.yearStart(year+1, loc)
+	if ref.Before(start) {
+		year, start, end = year-1, r.yearStart(year-1, loc), start
+	} else if !ref.Before(end) {
+		year, start, end = year+1, end, r.yearStart(year+2, loc)
+	}
+	return
+}
+
+// period gives the period number of ref, its start and number of days.
+func (r Retail) period(ref time.Time) (int, time.Time, int) {
+	_, start, end := r.locate(ref)
+	days, weeks, pattern := civilDays(start, ref), civilDays(start, end)/7, r.pattern()
+
+	num := 0
+	for p := 0; p < 12; p++ {
+		num = pattern[p%3] * 7
+		if p == 11 {
+			num += (weeks - 52) * 7
+		}
+		if days < num {
+			return p + 1, start, num
+		}
+		days, start = days-num, start.AddDate(0, 0, num)
+	}
+	return 12, start, num
+}
+
+// Value gives the value of segment at pos for ref.
+func (r Retail) Value(ref time.Time, pos int) int {
+	switch pos {
+	case 3:
+		_, start, _ := r.period(ref)
+		return civilDays(start, ref) + 1
+	case 4:
+		period, _, _ := r.period(ref)
+		return period
+	case 6:
+		year, _, _ := r.locate(ref)
+		return year
+	case 7:
+		_, start, _ := r.locate(ref)
+		return civilDays(start, ref)/7 + 1
+	case 8:
+		_, start, _ := r.locate(ref)
+		return civilDays(start, ref) + 1
+	}
+	return valueByPos(ref, pos)
+}
+
+// Bounds gives the min and max value of segment at pos.
+func (r Retail) Bounds(pos int) []int {
+	switch pos {
+	case 3:
+		return []int{1, 42}
+	case 8:
+		return []int{1, 371}
+	}
+	return boundsByPos(pos)
+}
+
+// LastDay gives the number of days in the period of ref.
+func (r Retail) LastDay(ref time.Time) int {
+	_, _, num := r.period(ref)
+	return num
+}
+
+// Bump gives the start of next (or end of prev if reverse) unit of segment at pos.
+func (r Retail) Bump(ref time.Time, pos int, reverse bool) time.Time {
+	var start, end time.Time
+	switch pos {
+	case 4:
+		var num int
+		_, start, num = r.period(ref)
+		end = start.AddDate(0, 0, num)
+	case 6:
+		_, start, end = r.locate(ref)
+	default:
+		return Gregorian{}.Bump(ref, pos, reverse)
+	}
+
+	if reverse {
+		return start.Add(-time.Second)
+	}
+	return end
+}
+
+// civilDays gives the number of calendar days from a to b ignoring DST.
+func civilDays(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
+// systemOf gives CalendarSystem of checker if it has one, Gregorian otherwise.
+func systemOf(c Checker) CalendarSystem {
+	if c, ok := c.(interface{ System() CalendarSystem }); ok {
+		return c.System()
+	}
+	return Gregorian{}
+}
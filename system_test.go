@@ -0,0 +1,84 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSystem(t *testing.T) {
+	parse := func(ref string) time.Time {
+		tm, _ := time.Parse(FullDateFormat, ref)
+		return tm
+	}
+
+	// NRF like 4-5-4 calendar, 2022 starts on 2022-01-30 and 2023 has 53 weeks
+	retail := Retail{Pattern: [3]int{4, 5, 4}, StartMonth: time.February, StartDay: 1, Weekday: time.Sunday}
+	fiscal := Fiscal{StartMonth: time.April}
+
+	t.Run("values", func(t *testing.T) {
+		tests := []struct {
+			sys    CalendarSystem
+			ref    string
+			expect [9]int
+		}{
+			{Gregorian{}, "2022-02-27 10:20:30", [9]int{30, 20, 10, 27, 2, 0, 2022, 8, 58}},
+			{fiscal, "2022-02-27 10:20:30", [9]int{30, 20, 10, 27, 11, 0, 2021, 8, 333}},
+			{fiscal, "2022-04-01 00:00:00", [9]int{0, 0, 0, 1, 1, 5, 2022, 13, 1}},
+			{retail, "2022-01-29 00:00:00", [9]int{0, 0, 0, 28, 12, 6, 2021, 52, 364}},
+			{retail, "2022-01-30 00:00:00", [9]int{0, 0, 0, 1, 1, 0, 2022, 1, 1}},
+			{retail, "2022-02-27 00:00:00", [9]int{0, 0, 0, 1, 2, 0, 2022, 5, 29}},
+			{retail, "2024-02-03 00:00:00", [9]int{0, 0, 0, 35, 12, 6, 2023, 53, 371}},
+		}
+		for _, test := range tests {
+			for pos, expect := range test.expect {
+				if actual := test.sys.Value(parse(test.ref), pos); actual != expect {
+					t.Errorf("%T value of %d on %s: expected %d, got %d", test.sys, pos, test.ref, expect, actual)
+				}
+			}
+		}
+		if last := retail.LastDay(parse("2024-01-01 00:00:00")); last != 35 {
+			t.Errorf("last day of 53 weeks year should be 35, got %d", last)
+		}
+	})
+
+	t.Run("next and prev tick", func(t *testing.T) {
+		tests := []struct {
+			sys                   CalendarSystem
+			expr, ref, next, prev string
+		}{
+			{fiscal, "0 0 1 1 *", "2022-02-01 00:00:00", "2022-04-01 00:00:00", "2021-04-01 00:00:00"},
+			{fiscal, "0 0 L 12 * 2022", "2022-02-01 00:00:00", "2023-03-31 00:00:00", ""},
+			{fiscal, "0 0 1 1 * 2022", "2023-02-01 00:00:00", "", "2022-04-01 00:00:00"},
+			{retail, "0 0 1 1,4,7,10 *", "2022-02-01 00:00:00", "2022-05-01 00:00:00", "2022-01-30 00:00:00"},
+			{retail, "0 0 * * 6L", "2022-02-01 00:00:00", "2022-02-26 00:00:00", "2022-01-29 00:00:00"},
+			{retail, "0 0 L 12 *", "2023-06-01 00:00:00", "2024-02-03 00:00:00", "2023-01-28 00:00:00"},
+			{retail, "0 0 1 * * 2023", "2022-06-01 00:00:00", "2023-01-29 00:00:00", ""},
+			{retail, "0 0 * * * W53", "2022-06-01 00:00:00", "2024-01-28 00:00:00", "2018-02-03 00:00:00"},
+		}
+		for _, test := range tests {
+			gron := New().WithSystem(test.sys)
+			if test.next != "" {
+				next, err := gron.NextTickAfter(test.expr, parse(test.ref), false)
+				if actual := next.Format(FullDateFormat); err != nil || actual != test.next {
+					t.Errorf("%T next of %s after %s: expected %s, got %s (%v)", test.sys, test.expr, test.ref, test.next, actual, err)
+				}
+			}
+			if test.prev != "" {
+				prev, err := gron.PrevTickBefore(test.expr, parse(test.ref), false)
+				if actual := prev.Format(FullDateFormat); err != nil || actual != test.prev {
+					t.Errorf("%T prev of %s before %s: expected %s, got %s (%v)", test.sys, test.expr, test.ref, test.prev, actual, err)
+				}
+			}
+		}
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		gron := New().WithSystem(retail)
+		if _, err := gron.IsDue("0 0 35 * *", parse("2022-02-01 00:00:00")); err != nil {
+			t.Errorf("35th day of period should be valid, got %v", err)
+		}
+		if _, err := New().IsDue("0 0 35 * *", parse("2022-02-01 00:00:00")); err == nil {
+			t.Error("35th day of month should be invalid")
+		}
+	})
+}
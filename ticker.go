@@ -0,0 +1,146 @@
+package gronx
+
+import (
+	"sync"
+	"time"
+)
+
+// TickerOption is the optional config for Ticker and AfterFunc.
+type TickerOption struct {
+	// Gron is used to compile expr (so its Calendar and CalendarSystem are respected).
+	// Defaults to New().
+	Gron *Gronx
+	// Location is the timezone in which expr is evaluated. Defaults to time.Local.
+	Location *time.Location
+	// Clock tells time and waits for ticks. Defaults to Clock of Gron or SystemClock.
+	Clock Clock
+}
+
+// Ticker delivers the due times of cron expr on its channel C.
+// It sleeps until next tick instead of polling every second.
+type Ticker struct {
+	C <-chan time.Time
+
+	opt   TickerOption
+	sched *Schedule
+	timer Timer
+	done  chan struct{}
+	fn    func(time.Time)
+	gen   int
+	mu    sync.Mutex
+}
+
+// NewTicker gives a Ticker whose channel receives the time of every tick of expr.
+// Like time.Ticker, the ticks are dropped if the receiver is not ready.
+// It returns error if expr is invalid or never fires.
+func NewTicker(expr string, opt ...TickerOption) (*Ticker, error) {
+	c := make(chan time.Time, 1)
+	t := &Ticker{C: c, fn: func(tick time.Time) {
+		select {
+		case c <- tick:
+		default:
+		}
+	}}
+	return t, t.start(expr, opt)
+}
+
+// AfterFunc calls f in its own goroutine at every tick of expr until stopped.
+// It returns the Ticker (whose C is nil) to stop or reset it, or error if expr is invalid.
+func AfterFunc(expr string, f func(), opt ...TickerOption) (*Ticker, error) {
+	t := &Ticker{fn: func(time.Time) { go f() }}
+	return t, t.start(expr, opt)
+}
+
+func (t *Ticker) start(expr string, opt []TickerOption) error {
+	t.opt = TickerOption{}
+	if len(opt) > 0 {
+		t.opt = opt[0]
+	}
+	if t.opt.Gron == nil {
+		t.opt.Gron = New()
+	}
+	if t.opt.Location == nil {
+		t.opt.Location = time.Local
+	}
+	if t.opt.Clock == nil {
+		t.opt.Clock = t.opt.Gron.Clock
+	}
+	if t.opt.Clock == nil {
+		t.opt.Clock = SystemClock{}
+	}
+	return t.Reset(expr)
+}
+
+// Reset stops the ticker and restarts it with new cron expr.
+// It returns error if expr is invalid or never fires, in which case the ticker stays stopped.
+func (t *Ticker) Reset(expr string) error {
+	sched, err := t.opt.Gron.Compile(expr)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stop()
+	if err != nil {
+		return err
+	}
+
+	t.sched = sched
+	return t.plan(t.now())
+}
+
+// Stop turns off the ticker, no more ticks are delivered after it.
+// Like time.Ticker, it does not close the channel.
+func (t *Ticker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stop()
+}
+
+func (t *Ticker) stop() {
+	t.gen++
+	if t.timer != nil {
+		t.timer.Stop()
+		close(t.done)
+		t.timer = nil
+	}
+}
+
+func (t *Ticker) now() time.Time {
+	return t.opt.Clock.Now().In(t.opt.Location)
+}
+
+// plan arms the timer for next tick after from.
+func (t *Ticker) plan(from time.Time) error {
+	next, err := t.sched.NextTickAfter(from, false)
+	if err != nil {
+		return err
+	}
+
+	gen, timer, done := t.gen, t.opt.Clock.NewTimer(next.Sub(t.now())), make(chan struct{})
+	t.timer, t.done = timer, done
+	go func() {
+		select {
+		case <-timer.C():
+			t.fire(gen, next)
+		case <-done:
+		}
+	}()
+	return nil
+}
+
+func (t *Ticker) fire(gen int, tick time.Time) {
+	t.mu.Lock()
+	if gen != t.gen {
+		t.mu.Unlock()
+		return
+	}
+
+	from := t.now()
+	if from.Before(tick) {
+		from = tick
+	}
+	t.timer = nil
+	t.plan(from)
+	t.mu.Unlock()
+
+	t.fn(tick)
+}
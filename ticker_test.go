@@ -0,0 +1,75 @@
+package gronx
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTicker(t *testing.T) {
+	t.Run("ticks", func(t *testing.T) {
+		ticker, err := NewTicker("* * * * * *", TickerOption{Location: time.UTC})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		defer ticker.Stop()
+
+		select {
+		case tick := <-ticker.C:
+			if tick.Nanosecond() != 0 || tick.Location() != time.UTC {
+				t.Errorf("expected whole second in UTC, got %v", tick)
+			}
+		case <-time.After(2 * time.Second):
+			t.Error("expected a tick within 2s")
+		}
+	})
+
+	t.Run("stop reset", func(t *testing.T) {
+		ticker, _ := NewTicker("* * * * * *")
+		ticker.Stop()
+		select {
+		case tick := <-ticker.C:
+			t.Errorf("expected no tick after stop, got %v", tick)
+		case <-time.After(1100 * time.Millisecond):
+		}
+
+		if err := ticker.Reset("* * *"); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+		if err := ticker.Reset("* * * * * *"); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+		defer ticker.Stop()
+		select {
+		case <-ticker.C:
+		case <-time.After(2 * time.Second):
+			t.Error("expected a tick within 2s after reset")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := NewTicker("* * *"); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+		if _, err := NewTicker("* * * * * 2018"); err == nil {
+			t.Error("expected error for expr that never fires")
+		}
+	})
+
+	t.Run("after func", func(t *testing.T) {
+		var count int32
+		ticker, err := AfterFunc("* * * * * *", func() { atomic.AddInt32(&count, 1) })
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+		if ticker.C != nil {
+			t.Error("expected nil channel")
+		}
+
+		time.Sleep(2100 * time.Millisecond)
+		ticker.Stop()
+		if n := atomic.LoadInt32(&count); n < 2 {
+			t.Errorf("expected at least 2 calls, got %d", n)
+		}
+	})
+}
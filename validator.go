@@ -73,8 +73,10 @@ func inStepRange(val, start, end, step int) bool {
 	return false
 }
 
-func isValidMonthDay(val string, last int, ref time.Time) (valid bool, err error) {
-	day, loc := ref.Day(), ref.Location()
+func isValidMonthDay(val string, day, last int, ref time.Time, cal Calendar) (valid bool, err error) {
+	if strings.HasSuffix(val, "BD") {
+		return isValidBusinessDay(val, day, last, ref, cal)
+	}
 	if val == "L" {
 		return day == last, nil
 	}
@@ -89,25 +91,61 @@ func isValidMonthDay(val string, last int, ref time.Time) (valid bool, err error
 		return false, err
 	}
 
-	for _, i := range []int{0, -1, 1, -2, 2} {
-		incr := i + nval
-		if incr > 0 && incr <= last {
-			iref := time.Date(ref.Year(), ref.Month(), incr, ref.Hour(), ref.Minute(), ref.Second(), 0, loc)
-			week := int(iref.Weekday())
+	// Closest business day in the same month: try 0, -1, +1, -2, +2 ... days apart
+	for i := 0; i < 2*last; i++ {
+		incr := nval + (i+1)/2
+		if i%2 == 1 {
+			incr = nval - (i+1)/2
+		}
+		if incr > 0 && incr <= last && isBusinessDay(ref.AddDate(0, 0, incr-day), cal) {
+			valid = day == incr
+			break
+		}
+	}
+
+	return valid, nil
+}
+
+// isValidBusinessDay checks nth (eg: 3BD) or last (LBD) business day of month.
+func isValidBusinessDay(val string, day, last int, ref time.Time, cal Calendar) (bool, error) {
+	if !isBusinessDay(ref, cal) {
+		return false, nil
+	}
 
-			if week > 0 && week < 6 && iref.Month() == ref.Month() {
-				valid = day == iref.Day()
-				break
+	if val == "LBD" {
+		for i := last; i > day; i-- {
+			if isBusinessDay(ref.AddDate(0, 0, i-day), cal) {
+				return false, nil
 			}
 		}
+		return true, nil
 	}
 
-	return valid, nil
+	nval, err := strconv.Atoi(strings.TrimSuffix(val, "BD"))
+	if err != nil {
+		return false, err
+	}
+	if nval < 1 || nval > 23 {
+		return false, errors.New("business day out of bounds(1, 23): " + val)
+	}
+
+	nth := 0
+	for i := 1; i <= day; i++ {
+		if isBusinessDay(ref.AddDate(0, 0, i-day), cal) {
+			nth++
+		}
+	}
+
+	return nth == nval, nil
 }
 
-func isValidWeekDay(val string, last int, ref time.Time) (bool, error) {
-	loc := ref.Location()
+// isBusinessDay tells if ref is MON-FRI and not excluded by calendar.
+func isBusinessDay(ref time.Time, cal Calendar) bool {
+	week := ref.Weekday()
+	return week > time.Sunday && week < time.Saturday && (cal == nil || !cal.IsExcluded(ref))
+}
 
+func isValidWeekDay(val string, day, last int, ref time.Time) (bool, error) {
 	if pos := strings.Index(val, "L"); pos > 0 {
 		nval, err := strconv.Atoi(val[0:pos])
 		if err != nil {
@@ -115,10 +153,8 @@ func isValidWeekDay(val string, last int, ref time.Time) (bool, error) {
 		}
 
 		for i := 0; i < 7; i++ {
-			day := last - i
-			dref := time.Date(ref.Year(), ref.Month(), day, ref.Hour(), ref.Minute(), ref.Second(), 0, loc)
-			if int(dref.Weekday()) == nval%7 {
-				return ref.Day() == day, nil
+			if int(ref.AddDate(0, 0, last-i-day).Weekday()) == nval%7 {
+				return day == last-i, nil
 			}
 		}
 	}
@@ -129,7 +165,7 @@ func isValidWeekDay(val string, last int, ref time.Time) (bool, error) {
 		return false, errors.New("invalid offset value: " + val)
 	}
 
-	day, err := strconv.Atoi(parts[0])
+	week, err := strconv.Atoi(parts[0])
 	if err != nil {
 		return false, err
 	}
@@ -139,9 +175,9 @@ func isValidWeekDay(val string, last int, ref time.Time) (bool, error) {
 		return false, err
 	}
 
-	if day < 0 || day > 7 || nth < 1 || nth > 5 || int(ref.Weekday()) != day {
+	if week < 0 || week > 7 || nth < 1 || nth > 5 || int(ref.Weekday()) != week {
 		return false, nil
 	}
 
-	return (ref.Day()-1)/7 == nth-1, nil
+	return (day-1)/7 == nth-1, nil
 }
@@ -0,0 +1,101 @@
+package gronx
+
+import (
+	"errors"
+	"time"
+)
+
+// Window is a recurring time window that opens whenever Expr is due
+// and stays open for Duration, eg: maintenance window `0 2 * * SUN` lasting 3h.
+type Window struct {
+	Expr     string
+	Duration time.Duration
+	gron     *Gronx
+}
+
+// NewWindow creates a Window for given cron expr and duration.
+// It returns Window or error if expr is invalid or duration is not positive.
+func NewWindow(expr string, dur time.Duration) (Window, error) {
+	return New().Window(expr, dur)
+}
+
+// Window creates a Window for given cron expr and duration that skips the days
+// excluded by Calendar if any and tells current time by Clock.
+// It returns Window or error if expr is invalid or duration is not positive.
+func (g *Gronx) Window(expr string, dur time.Duration) (Window, error) {
+	if !g.IsValid(expr) {
+		return Window{}, errors.New("invalid cron expr: " + expr)
+	}
+	if dur <= 0 {
+		return Window{}, errors.New("window duration must be positive")
+	}
+
+	return Window{expr, dur, g}, nil
+}
+
+// g gives a copy of the Gronx of window per call, so that a Window and its copies are safe for concurrent use.
+func (w Window) g() *Gronx {
+	if w.gron == nil {
+		return New()
+	}
+	return w.gron.clone()
+}
+
+// IsActive checks if the window is open at given time (or now if not given).
+func (w Window) IsActive(ref ...time.Time) bool {
+	g := w.g()
+	if len(ref) == 0 {
+		ref = append(ref, g.now())
+	}
+
+	start, err := g.PrevTickBefore(w.Expr, ref[0], true)
+	return err == nil && ref[0].Before(start.Add(w.Duration))
+}
+
+// NextStart gives the time window opens next after given time.
+func (w Window) NextStart(ref time.Time) (time.Time, error) {
+	return w.g().NextTickAfter(w.Expr, ref, false)
+}
+
+// NextEnd gives the time window closes next after given time.
+// If window is open at ref, it is the end of that very window.
+func (w Window) NextEnd(ref time.Time) (time.Time, error) {
+	if start, err := w.g().PrevTickBefore(w.Expr, ref, true); err == nil && ref.Before(start.Add(w.Duration)) {
+		return start.Add(w.Duration), nil
+	}
+
+	start, err := w.NextStart(ref)
+	if err != nil {
+		return start, err
+	}
+	return start.Add(w.Duration), nil
+}
+
+// Overlaps checks if any window of w overlaps with any window of other
+// opening between from and until. No further start of either window is no overlap.
+// It returns bool or error if expr of either window is invalid.
+func (w Window) Overlaps(other Window, from, until time.Time) (bool, error) {
+	for _, win := range []Window{w, other} {
+		if !win.g().IsValid(win.Expr) {
+			return false, errors.New("invalid cron expr: " + win.Expr)
+		}
+	}
+
+	start, err := w.g().PrevTickBefore(w.Expr, from, true)
+	if err != nil || !from.Before(start.Add(w.Duration)) {
+		start, err = w.NextStart(from)
+	}
+
+	for err == nil && !start.After(until) {
+		if other.IsActive(start) {
+			return true, nil
+		}
+		if next, oerr := other.NextStart(start); oerr == nil && next.Before(start.Add(w.Duration)) {
+			return true, nil
+		}
+
+		start, err = w.NextStart(start)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,144 @@
+package gronx
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWindow(t *testing.T) {
+	parse := func(ref string) time.Time {
+		tm, _ := time.Parse(FullDateFormat, ref)
+		return tm
+	}
+
+	t.Run("new window", func(t *testing.T) {
+		if _, err := NewWindow("0 2 * * SUN", 3*time.Hour); err != nil {
+			t.Errorf("expected nil, got %v", err)
+		}
+		if _, err := NewWindow("0 2 * *", 3*time.Hour); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+		if _, err := NewWindow("0 2 * * SUN", 0); err == nil {
+			t.Error("expected error for zero duration")
+		}
+	})
+
+	// 2022-11-06 is a Sunday
+	win, _ := NewWindow("0 2 * * SUN", 3*time.Hour)
+
+	t.Run("is active", func(t *testing.T) {
+		tests := map[string]bool{
+			"2022-11-06 01:59:59": false,
+			"2022-11-06 02:00:00": true,
+			"2022-11-06 04:59:59": true,
+			"2022-11-06 05:00:00": false,
+			"2022-11-07 03:00:00": false,
+		}
+		for ref, expect := range tests {
+			if actual := win.IsActive(parse(ref)); actual != expect {
+				t.Errorf("active on %s: expected %v, got %v", ref, expect, actual)
+			}
+		}
+	})
+
+	t.Run("next start and end", func(t *testing.T) {
+		tests := []struct{ ref, start, end string }{
+			{"2022-11-05 10:00:00", "2022-11-06 02:00:00", "2022-11-06 05:00:00"},
+			{"2022-11-06 03:00:00", "2022-11-13 02:00:00", "2022-11-06 05:00:00"},
+			{"2022-11-06 05:00:00", "2022-11-13 02:00:00", "2022-11-13 05:00:00"},
+		}
+		for _, test := range tests {
+			start, _ := win.NextStart(parse(test.ref))
+			if actual := start.Format(FullDateFormat); actual != test.start {
+				t.Errorf("next start of %s: expected %s, got %s", test.ref, test.start, actual)
+			}
+			end, _ := win.NextEnd(parse(test.ref))
+			if actual := end.Format(FullDateFormat); actual != test.end {
+				t.Errorf("next end of %s: expected %s, got %s", test.ref, test.end, actual)
+			}
+		}
+	})
+
+	t.Run("overlaps", func(t *testing.T) {
+		from, until := parse("2022-11-01 00:00:00"), parse("2022-11-30 00:00:00")
+		tests := []struct {
+			expr   string
+			dur    time.Duration
+			expect bool
+		}{
+			{"30 4 * * SUN", time.Minute, true},
+			{"0 1 * * SUN", 90 * time.Minute, true},
+			{"0 1 * * SUN", time.Hour, false},
+			{"0 2 * * SAT", 3 * time.Hour, false},
+			{"0 23 * * SAT", 4 * time.Hour, true},
+		}
+		for _, test := range tests {
+			other, _ := NewWindow(test.expr, test.dur)
+			if actual, _ := win.Overlaps(other, from, until); actual != test.expect {
+				t.Errorf("overlap with %s for %s: expected %v, got %v", test.expr, test.dur, test.expect, actual)
+			}
+		}
+	})
+	t.Run("overlaps without next start", func(t *testing.T) {
+		gron := New().WithCalendar(cutoffCalendar(parse("2022-11-07 00:00:00")))
+		last, _ := gron.Window("0 2 * * *", time.Hour)
+		other, _ := NewWindow("0 12 * * *", time.Hour)
+		from, until := parse("2022-11-05 00:00:00"), parse("2022-11-30 00:00:00")
+		if overlaps, err := last.Overlaps(other, from, until); overlaps || err != nil {
+			t.Errorf("expected false, nil when window has no next start, got %v, %v", overlaps, err)
+		}
+		if overlaps, err := other.Overlaps(last, from, until); overlaps || err != nil {
+			t.Errorf("expected false, nil when other window has no next start, got %v, %v", overlaps, err)
+		}
+		if _, err := last.Overlaps(Window{Expr: "0 2 * *", Duration: time.Hour}, from, until); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				ref := parse("2022-11-06 02:30:00").AddDate(0, 0, 7*i)
+				if !win.IsActive(ref) {
+					t.Errorf("expected active at %s", ref)
+				}
+				if start, err := win.NextStart(ref); err != nil || start.Sub(ref) != 7*24*time.Hour-30*time.Minute {
+					t.Errorf("expected next start a week after %s, got %s (%v)", ref, start, err)
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	t.Run("calendar and clock", func(t *testing.T) {
+		clock := NewFakeClock(parse("2022-11-06 03:00:00"))
+		gron := New().WithClock(clock).WithCalendar(NewDateCalendar(parse("2022-11-06 00:00:00")))
+		win, _ := gron.Window("0 2 * * SUN", 3*time.Hour)
+
+		if win.IsActive() {
+			t.Error("expected inactive on excluded day")
+		}
+		if start, _ := win.NextStart(parse("2022-11-05 10:00:00")); start.Format(FullDateFormat) != "2022-11-13 02:00:00" {
+			t.Errorf("expected 2022-11-13 02:00:00, got %s", start.Format(FullDateFormat))
+		}
+
+		clock.Set(parse("2022-11-13 04:00:00"))
+		if !win.IsActive() {
+			t.Error("expected active now")
+		}
+		if _, err := gron.Window("0 2 * *", time.Hour); err == nil {
+			t.Error("expected error for invalid expr")
+		}
+	})
+}
+
+// cutoffCalendar excludes all the days from given time on.
+type cutoffCalendar time.Time
+
+func (c cutoffCalendar) IsExcluded(ref time.Time) bool {
+	return !ref.Before(time.Time(c))
+}